@@ -12,6 +12,39 @@ func (node *NumberExpression) GetLine() uint {
 	return node.Line
 }
 
+// Number literals without a fraction ---
+type IntegerExpression struct {
+	Value int64
+	Line  uint
+}
+
+func (node *IntegerExpression) Expression() {}
+func (node *IntegerExpression) GetLine() uint {
+	return node.Line
+}
+
+// `123n`, digits kept as text ---
+type BigIntExpression struct {
+	Value string
+	Line  uint
+}
+
+func (node *BigIntExpression) Expression() {}
+func (node *BigIntExpression) GetLine() uint {
+	return node.Line
+}
+
+// `d"19.99"`, digits kept as text ---
+type DecimalExpression struct {
+	Value string
+	Line  uint
+}
+
+func (node *DecimalExpression) Expression() {}
+func (node *DecimalExpression) GetLine() uint {
+	return node.Line
+}
+
 type StringExpression struct {
 	Value string
 	Line  uint
@@ -58,6 +91,7 @@ func (node *UnaryExpression) GetLine() uint {
 type AssignmentExpression struct {
 	Operator lexer.TokenType
 	Assignee Expression
+	Pattern  Pattern // Set when the assignee is an array or object literal ---
 	Value    Expression
 	Line     uint
 }
@@ -70,6 +104,7 @@ func (node *AssignmentExpression) GetLine() uint {
 type CallExpression struct {
 	Caller    Expression
 	Arguments []Expression
+	Optional  bool // f?.(x) ---
 	Line      uint
 }
 
@@ -89,9 +124,10 @@ func (a *ArrayExpression) GetLine() uint {
 }
 
 type IndexExpression struct {
-	Expr   Expression
-	Index  Expression
-	Line   uint
+	Expr     Expression
+	Index    Expression
+	Optional bool // a?.[k] ---
+	Line     uint
 }
 
 func (i *IndexExpression) Expression() {}
@@ -99,6 +135,7 @@ func (i *IndexExpression) GetLine() uint {
 	return i.Line
 }
 
+// Spread properties (`...base`) have an empty Key and a *SpreadExpression Value ---
 type ObjectProperty struct {
 	Key string
 	Value Expression
@@ -114,9 +151,52 @@ func (i *ObjectExpression) GetLine() uint {
 	return i.Line
 }
 
+// #{ key: value, ... }, keys are any expression ---
+type MapExpression struct {
+	Entries []MapEntry
+	Line    uint
+}
+
+type MapEntry struct {
+	Key   Expression
+	Value Expression
+}
+
+func (node *MapExpression) Expression() {}
+func (node *MapExpression) GetLine() uint {
+	return node.Line
+}
+
+// #[ a, b, ...rest ] ---
+type SetExpression struct {
+	Elements []Expression
+	Line     uint
+}
+
+func (node *SetExpression) Expression() {}
+func (node *SetExpression) GetLine() uint {
+	return node.Line
+}
+
+// target[start:end:step], any part may be left out ---
+type SliceExpression struct {
+	Expr     Expression
+	Start    Expression
+	End      Expression
+	Step     Expression
+	Optional bool
+	Line     uint
+}
+
+func (node *SliceExpression) Expression() {}
+func (node *SliceExpression) GetLine() uint {
+	return node.Line
+}
+
 type MemberExpression struct {
 	Object   Expression
 	Property string
+	Optional bool // a?.b ---
 	Line     uint
 }
 
@@ -135,3 +215,133 @@ func (p *PostfixExpression) Expression() {}
 func (p *PostfixExpression) GetLine() uint {
 	return p.Line
 }
+
+type FunctionExpression struct {
+	Parameters  []Pattern
+	Body        Statement
+	IsGenerator bool // Declared with `fn*` ---
+	Line        uint
+}
+
+func (f *FunctionExpression) Expression() {}
+func (f *FunctionExpression) GetLine() uint {
+	return f.Line
+}
+
+type RangeExpression struct {
+	Start     Expression
+	End       Expression
+	Step      Expression
+	Inclusive bool // start..=end ---
+	Line      uint
+}
+
+func (r *RangeExpression) Expression() {}
+func (r *RangeExpression) GetLine() uint {
+	return r.Line
+}
+
+type TernaryExpression struct {
+	Condition  Expression
+	Consequent Expression
+	Alternate  Expression
+	Line       uint
+}
+
+func (t *TernaryExpression) Expression() {}
+func (t *TernaryExpression) GetLine() uint {
+	return t.Line
+}
+
+type ThisExpression struct {
+	Line uint
+}
+
+func (t *ThisExpression) Expression() {}
+func (t *ThisExpression) GetLine() uint {
+	return t.Line
+}
+
+type SuperExpression struct {
+	Method string
+	Line   uint
+}
+
+func (s *SuperExpression) Expression() {}
+func (s *SuperExpression) GetLine() uint {
+	return s.Line
+}
+
+type SpreadExpression struct {
+	Value Expression
+	Line  uint
+}
+
+func (s *SpreadExpression) Expression() {}
+func (s *SpreadExpression) GetLine() uint {
+	return s.Line
+}
+
+type NamedArgumentExpression struct {
+	Name  string
+	Value Expression
+	Line  uint
+}
+
+func (n *NamedArgumentExpression) Expression() {}
+func (n *NamedArgumentExpression) GetLine() uint {
+	return n.Line
+}
+
+// Strings always holds one more element than Expressions; they interleave
+// starting and ending with a (possibly empty) string ---
+type TemplateExpression struct {
+	Strings     []string
+	Expressions []Expression
+	Line        uint
+}
+
+func (t *TemplateExpression) Expression() {}
+func (t *TemplateExpression) GetLine() uint {
+	return t.Line
+}
+
+type MatchArm struct {
+	Pattern Pattern
+	Guard   Expression // nil when the arm has no 'if' guard ---
+	Body    Statement
+}
+
+type MatchExpression struct {
+	Subject Expression
+	Arms    []MatchArm
+	Line    uint
+}
+
+func (m *MatchExpression) Expression() {}
+func (m *MatchExpression) GetLine() uint {
+	return m.Line
+}
+
+// Value is nil for a bare `yield` ---
+type YieldExpression struct {
+	Value Expression
+	Line  uint
+}
+
+func (y *YieldExpression) Expression() {}
+func (y *YieldExpression) GetLine() uint {
+	return y.Line
+}
+
+// Value is either a call, which runs on the new task with arguments
+// evaluated up front, or any callable expression run with no arguments ---
+type SpawnExpression struct {
+	Value Expression
+	Line  uint
+}
+
+func (s *SpawnExpression) Expression() {}
+func (s *SpawnExpression) GetLine() uint {
+	return s.Line
+}
@@ -0,0 +1,159 @@
+package ast
+
+// Patterns describe the targets of destructuring declarations,
+// assignments and function parameters ---
+type Pattern interface {
+	Pattern()
+	GetLine() uint
+}
+
+type IdentifierPattern struct {
+	Name string
+	Line uint
+}
+
+func (i *IdentifierPattern) Pattern() {}
+func (i *IdentifierPattern) GetLine() uint {
+	return i.Line
+}
+
+// A nil element marks a skipped position, e.g. `[a, , c]` ---
+type ArrayPattern struct {
+	Elements []Pattern
+	Line     uint
+}
+
+func (a *ArrayPattern) Pattern() {}
+func (a *ArrayPattern) GetLine() uint {
+	return a.Line
+}
+
+type ObjectPatternProperty struct {
+	Key   string
+	Value Pattern
+}
+
+type ObjectPattern struct {
+	Properties []ObjectPatternProperty
+	Rest       Pattern
+	Line       uint
+}
+
+func (o *ObjectPattern) Pattern() {}
+func (o *ObjectPattern) GetLine() uint {
+	return o.Line
+}
+
+type RestPattern struct {
+	Target Pattern
+	Line   uint
+}
+
+func (r *RestPattern) Pattern() {}
+func (r *RestPattern) GetLine() uint {
+	return r.Line
+}
+
+type DefaultPattern struct {
+	Target  Pattern
+	Default Expression
+	Line    uint
+}
+
+func (d *DefaultPattern) Pattern() {}
+func (d *DefaultPattern) GetLine() uint {
+	return d.Line
+}
+
+// Member and index targets, only valid in destructuring assignments ---
+type ExpressionPattern struct {
+	Target Expression
+	Line   uint
+}
+
+func (e *ExpressionPattern) Pattern() {}
+func (e *ExpressionPattern) GetLine() uint {
+	return e.Line
+}
+
+// Match-only patterns ---
+
+// '_' matches any value without binding it ---
+type WildcardPattern struct {
+	Line uint
+}
+
+func (w *WildcardPattern) Pattern() {}
+func (w *WildcardPattern) GetLine() uint {
+	return w.Line
+}
+
+// Matches when the value equals the evaluated expression
+// (literals, true/false/nil and dotted names like Color.Red) ---
+type LiteralPattern struct {
+	Value Expression
+	Line  uint
+}
+
+func (l *LiteralPattern) Pattern() {}
+func (l *LiteralPattern) GetLine() uint {
+	return l.Line
+}
+
+// `Result.Ok(value)`, matches a variant and its associated values ---
+type VariantPattern struct {
+	Variant Expression
+	Fields  []Pattern
+	Line    uint
+}
+
+func (v *VariantPattern) Pattern() {}
+func (v *VariantPattern) GetLine() uint {
+	return v.Line
+}
+
+// `a | b | c`, tried left to right ---
+type AlternativePattern struct {
+	Alternatives []Pattern
+	Line         uint
+}
+
+func (a *AlternativePattern) Pattern() {}
+func (a *AlternativePattern) GetLine() uint {
+	return a.Line
+}
+
+// Lists every variable name a pattern binds, in source order ---
+func BoundNames(pattern Pattern) []string {
+	var names []string
+
+	switch p := pattern.(type) {
+	case *IdentifierPattern:
+		names = append(names, p.Name)
+	case *ArrayPattern:
+		for _, element := range p.Elements {
+			if element != nil {
+				names = append(names, BoundNames(element)...)
+			}
+		}
+	case *ObjectPattern:
+		for _, property := range p.Properties {
+			names = append(names, BoundNames(property.Value)...)
+		}
+		if p.Rest != nil {
+			names = append(names, BoundNames(p.Rest)...)
+		}
+	case *RestPattern:
+		names = append(names, BoundNames(p.Target)...)
+	case *DefaultPattern:
+		names = append(names, BoundNames(p.Target)...)
+	case *AlternativePattern:
+		names = append(names, BoundNames(p.Alternatives[0])...)
+	case *VariantPattern:
+		for _, field := range p.Fields {
+			names = append(names, BoundNames(field)...)
+		}
+	}
+
+	return names
+}
@@ -23,6 +23,7 @@ func (node *ExpressionStatement) GetLine() uint {
 type VariableDeclarationStatement struct {
 	IsConstant bool
 	Identifier string
+	Pattern    Pattern // Set instead of Identifier for destructuring declarations ---
 	Value      Expression
 	Line       uint
 }
@@ -46,10 +47,11 @@ func (i *IfStatement) GetLine() uint {
 func (i *IfStatement) Statement() {}
 
 type FunctionDeclarationStatement struct {
-	Name       string
-	Parameters []string
-	Body       Statement
-	Line       uint
+	Name        string
+	Parameters  []Pattern
+	Body        Statement
+	IsGenerator bool // Declared with `fn*` ---
+	Line        uint
 }
 
 func (f *FunctionDeclarationStatement) Statement() {}
@@ -57,6 +59,35 @@ func (f *FunctionDeclarationStatement) GetLine() uint {
 	return f.Line
 }
 
+type ClassDeclarationStatement struct {
+	Name       string
+	Superclass Expression
+	Methods    []*FunctionDeclarationStatement
+	Line       uint
+}
+
+func (c *ClassDeclarationStatement) Statement() {}
+func (c *ClassDeclarationStatement) GetLine() uint {
+	return c.Line
+}
+
+// Fields is empty for plain variants, e.g. `Red` vs `Ok(value)` ---
+type EnumVariant struct {
+	Name   string
+	Fields []string
+}
+
+type EnumDeclarationStatement struct {
+	Name     string
+	Variants []EnumVariant
+	Line     uint
+}
+
+func (e *EnumDeclarationStatement) Statement() {}
+func (e *EnumDeclarationStatement) GetLine() uint {
+	return e.Line
+}
+
 type WhileLoopStatement struct {
 	Condition Expression
 	Body      Statement
@@ -81,6 +112,19 @@ func (f *ForStatement) GetLine() uint {
 	return f.Line
 }
 
+type ForOfStatement struct {
+	IsConstant  bool
+	Identifiers []string
+	Iterable    Expression
+	Body        Statement
+	Line        uint
+}
+
+func (f *ForOfStatement) Statement() {}
+func (f *ForOfStatement) GetLine() uint {
+	return f.Line
+}
+
 type ReturnStatement struct {
 	Value Expression
 	Line  uint
@@ -102,3 +146,61 @@ type ContinueStatement struct {
 
 func (c *ContinueStatement) GetLine() uint { return c.Line }
 func (c *ContinueStatement) Statement() {}
+
+type TryStatement struct {
+	Body       Statement
+	CatchParam string
+	Catch      Statement
+	Finally    Statement
+	Line       uint
+}
+
+func (t *TryStatement) GetLine() uint { return t.Line }
+func (t *TryStatement) Statement()    {}
+
+type ThrowStatement struct {
+	Value Expression
+	Line  uint
+}
+
+func (t *ThrowStatement) GetLine() uint { return t.Line }
+func (t *ThrowStatement) Statement()    {}
+
+type ImportStatement struct {
+	Path  string
+	Names []string
+	Alias string
+	Line  uint
+}
+
+func (i *ImportStatement) GetLine() uint { return i.Line }
+func (i *ImportStatement) Statement()    {}
+
+type ExportStatement struct {
+	Declaration Statement
+	Line        uint
+}
+
+func (e *ExportStatement) GetLine() uint { return e.Line }
+func (e *ExportStatement) Statement()    {}
+
+// One `channel.recv() as name => body` or `channel.send(value) => body` arm ---
+type SelectCase struct {
+	Channel Expression
+	IsSend  bool
+	Value   Expression // Sent value, nil for recv ---
+	Binding string     // Name bound to the received value, may be empty ---
+	Body    Statement
+}
+
+// Default is the `_ => body` arm, nil when select should block ---
+type SelectStatement struct {
+	Cases   []SelectCase
+	Default Statement
+	Line    uint
+}
+
+func (s *SelectStatement) Statement() {}
+func (s *SelectStatement) GetLine() uint {
+	return s.Line
+}
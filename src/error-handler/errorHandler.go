@@ -4,9 +4,21 @@ import (
 	"fmt"
 )
 
+type Error struct {
+	File     string
+	Reporter string
+	Message  string
+	Line     uint
+	Code     ErrorType
+}
+
 type ErrorHandler struct {
 	HadError     bool
 	ErrorMessage ErrorType
+	LastError    *Error
+	File         string // Script currently being run, named in error messages ---
+
+	catchDepth int
 }
 
 func New() *ErrorHandler {
@@ -23,5 +35,63 @@ func (e *ErrorHandler) ReportError(reporter, errorMessage string, line uint, cod
 	}
 
 	e.HadError = true
+	e.LastError = &Error{
+		File:     e.File,
+		Reporter: reporter,
+		Message:  errorMessage,
+		Line:     line,
+		Code:     code,
+	}
+
+	if e.catchDepth > 0 { // A surrounding try statement decides what happens next ---
+		return
+	}
+
+	if e.File != "" {
+		fmt.Printf("%s::Error in %s on line %d: %s\n", reporter, e.File, int(line), errorMessage)
+		return
+	}
+
 	fmt.Printf("%s::Error on line %d: %s\n", reporter, int(line), errorMessage)
 }
+
+// Errors reported between BeginCatch and EndCatch are held back instead of printed ---
+func (e *ErrorHandler) BeginCatch() {
+	e.catchDepth++
+}
+
+func (e *ErrorHandler) EndCatch() {
+	e.catchDepth--
+}
+
+// Lets code that suspends execution (generators) save and restore how
+// many try statements currently surround it ---
+func (e *ErrorHandler) CatchDepth() int {
+	return e.catchDepth
+}
+
+func (e *ErrorHandler) SetCatchDepth(depth int) {
+	e.catchDepth = depth
+}
+
+// Clears the error state and hands back the error that caused it ---
+func (e *ErrorHandler) Recover() *Error {
+	err := e.LastError
+	e.HadError = false
+	e.LastError = nil
+	return err
+}
+
+// Puts back an error taken with Recover without reporting it a second time ---
+func (e *ErrorHandler) Restore(err *Error) {
+	e.HadError = true
+	e.LastError = err
+}
+
+// Reports a previously recovered error again, e.g. when no catch clause handled it ---
+func (e *ErrorHandler) Raise(err *Error) {
+	currentFile := e.File
+	e.File = err.File
+	e.ReportError(err.Reporter, err.Message, err.Line, err.Code)
+	e.File = currentFile
+}
@@ -18,4 +18,13 @@ const (
 	VariableDeclarationError ErrorType = "VARIABLE_DECL_ERR"
 	InvalidPostfixExpressionError ErrorType = "INVALID_POSTFIX_EXPR_ERR"
 	IllegalStatementError ErrorType = "ILLEGAL_STATEMENT_ERR"
+	ClassError ErrorType = "CLASS_ERR"
+	ThrowError ErrorType = "THROW_ERR"
+	ModuleError ErrorType = "MODULE_ERR"
+	InvalidEscapeError ErrorType = "INVALID_ESCAPE_ERR"
+	MatchError ErrorType = "MATCH_ERR"
+	GeneratorError ErrorType = "GENERATOR_ERR"
+	GeneratorClosedError ErrorType = "GENERATOR_CLOSED_ERR" // Unwinds a closed generator, never caught ---
+	ChannelError ErrorType = "CHANNEL_ERR"
+	TaskError ErrorType = "TASK_ERR"
 )
@@ -13,6 +13,7 @@ import (
 
 var ErrorHandler = errorhandler.New()
 var Environment = runtime.NewEnvironment(nil, ErrorHandler)
+var Interpreter = runtime.NewInterpreter(ErrorHandler, Environment)
 
 func Lento() {
 	if len(os.Args) > 2 {
@@ -33,8 +34,12 @@ func runFile(filepath string) {
 		fmt.Printf("An error occurred whilst trying to read %s:\n%s\n", filepath, error.Error())
 	}
 
+	ErrorHandler.File = filepath
+	Interpreter.SetModulePath(filepath)
+
 	// start := time.Now()
 	run(string(bytes))
+	Interpreter.FinishModule()
 	// duration := time.Since(start)
 
 	// fmt.Printf("File took %s of execution time\n", duration)
@@ -65,7 +70,6 @@ func runRepl() {
 
 func run(sourceCode string) runtime.RuntimeValue {
 	lexer := lexer.NewLexer(sourceCode, ErrorHandler)
-	interpreter := runtime.NewInterpreter(ErrorHandler, Environment)
 
 	tokens := lexer.Tokenize()
 	if ErrorHandler.HadError {
@@ -81,7 +85,7 @@ func run(sourceCode string) runtime.RuntimeValue {
 
 	var result runtime.RuntimeValue
 	for _, statement := range ast.Body {
-		result = interpreter.EvaluateStatement(statement, Environment)
+		result = Interpreter.EvaluateStatement(statement, Environment)
 		if ErrorHandler.HadError {
 			return nil
 		}
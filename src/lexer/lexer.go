@@ -2,7 +2,10 @@ package lexer
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
+	"unicode"
 
 	errorhandler "github.com/caelondev/lento/src/error-handler"
 )
@@ -63,7 +66,7 @@ func (l *Lexer) AnalyzeTokens() {
 	case ']':
 		l.addToken(RIGHT_BRACKET)
 	case '.':
-		l.addToken(DOT)
+		l.handleDot()
 	case ',':
 		l.addToken(COMMA)
 	case ';':
@@ -71,15 +74,27 @@ func (l *Lexer) AnalyzeTokens() {
 	case '!':
 		l.handleCompound(BANG, NOT_EQUAL)
 	case '=':
-		l.handleCompound(ASSIGNMENT, EQUAL)
+		l.handleEquals()
 	case '<':
-		l.handleCompound(LESS, LESS_EQUAL)
+		l.handleAngle('<', LESS, LESS_EQUAL, SHIFT_LEFT)
 	case '>':
-		l.handleCompound(GREATER, GREATER_EQUAL)
+		l.handleAngle('>', GREATER, GREATER_EQUAL, SHIFT_RIGHT)
 	case ':':
 		l.addToken(COLON)
+	case '?':
+		l.handleQuestion()
+	case '|':
+		l.addToken(PIPE)
+	case '&':
+		l.addToken(AMPERSAND)
+	case '^':
+		l.addToken(CARET)
+	case '~':
+		l.handleTilde()
+	case '#':
+		l.handleHash()
 	case '*':
-		l.handleCompound(STAR, STAR_EQUALS)
+		l.handleStar()
 	case '%':
 		l.handleCompound(MODULO, MODULO_EQUALS)
 	case '-':
@@ -102,7 +117,11 @@ func (l *Lexer) AnalyzeTokens() {
 	default:
 		if isNumber(char) {
 			l.handleNumbers()
-		} else if isAlphabet(char) { // Handle identifiers and keywords
+		} else if char == 'r' && (l.peek() == '"' || l.peek() == '\'') {
+			l.handleRawString()
+		} else if char == 'd' && (l.peek() == '"' || l.peek() == '\'') {
+			l.handleDecimal()
+		} else if isAlphabet(char) || isUnderscore(char) { // Handle identifiers and keywords
 			l.handleIdentifier()
 		} else {
 			l.ErrorHandler.ReportError(
@@ -118,11 +137,34 @@ func (l *Lexer) AnalyzeTokens() {
 
 func (l *Lexer) handleMultilineString() {
 	startLine := l.Line
+	parts := make([]TemplatePart, 0)
+	var builder strings.Builder
+
 	for !l.isEOF() && l.peek() != '`' {
+		if l.peek() == '$' && l.peekNext() == '{' {
+			parts = append(parts, TemplatePart{Text: builder.String()})
+			builder.Reset()
+
+			tokens, ok := l.handleTemplateExpression()
+			if !ok {
+				return
+			}
+
+			parts = append(parts, TemplatePart{Tokens: tokens})
+			continue
+		}
+
+		if l.peek() == '\\' {
+			if !l.handleEscape(&builder) {
+				return
+			}
+			continue
+		}
+
 		if l.peek() == '\n' {
 			l.Line++
 		}
-		l.advance()
+		builder.WriteRune(l.advance())
 	}
 	if l.isEOF() {
 		l.ErrorHandler.ReportError(
@@ -133,17 +175,138 @@ func (l *Lexer) handleMultilineString() {
 		)
 		return
 	}
+
 	l.match('`') // Check and eat '`' closing string --- 
-	literal := string(l.SourceCode[l.Start+1 : l.Current-1])
-	l.addTokenWithLiteral(STRING, literal, startLine)
+
+	// Plain multiline strings stay regular STRING tokens ---
+	if len(parts) == 0 {
+		l.addTokenWithLiteral(STRING, builder.String(), startLine)
+		return
+	}
+
+	parts = append(parts, TemplatePart{Text: builder.String()})
+	l.addTokenWithLiteral(TEMPLATE, parts, startLine)
+}
+
+// Tokenizes the source of a `${...}` interpolation with a nested lexer ---
+func (l *Lexer) handleTemplateExpression() ([]*Token, bool) {
+	l.advance() // Eat '$' ---
+	l.advance() // Eat '{' ---
+
+	expressionStart := l.Current
+	expressionLine := l.Line
+
+	if !l.skipTemplateExpression() {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			"Unterminated template expression, expected '}'",
+			l.Line,
+			errorhandler.UnterminatedError,
+		)
+		return nil, false
+	}
+
+	source := string(l.SourceCode[expressionStart:l.Current])
+	l.advance() // Eat '}' ---
+
+	nested := NewLexer(source, l.ErrorHandler)
+	nested.Line = expressionLine
+	tokens := nested.Tokenize()
+
+	return tokens, !l.ErrorHandler.HadError
+}
+
+// Moves to the '}' closing a `${` expression. Braces inside strings and
+// nested templates don't count, so `${"}"}` ends at the right place ---
+func (l *Lexer) skipTemplateExpression() bool {
+	depth := 1
+
+	for !l.isEOF() {
+		switch char := l.peek(); char {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return true
+			}
+		case '"', '\'':
+			l.skipQuoted(char)
+			continue
+		case '`':
+			if !l.skipTemplate() {
+				return false
+			}
+			continue
+		case '\n':
+			l.Line++
+		}
+		l.advance()
+	}
+	return false
+}
+
+// Steps over a quoted string; the nested lexer reads it properly later ---
+func (l *Lexer) skipQuoted(quote rune) {
+	raw := l.isRawStringPrefix()
+	l.advance() // Eat opening quote ---
+
+	for !l.isEOF() && l.peek() != quote && l.peek() != '\n' {
+		if l.advance() == '\\' && !raw && !l.isEOF() {
+			l.advance() // Escaped character ---
+		}
+	}
+	l.match(quote)
+}
+
+// r"..." strings keep backslashes as they are ---
+func (l *Lexer) isRawStringPrefix() bool {
+	if l.Current < 1 || l.SourceCode[l.Current-1] != 'r' {
+		return false
+	}
+	return l.Current < 2 || !(isAlphabet(l.SourceCode[l.Current-2]) || isNumber(l.SourceCode[l.Current-2]) || isUnderscore(l.SourceCode[l.Current-2]))
+}
+
+// Steps over a nested template literal, including its own `${...}` parts ---
+func (l *Lexer) skipTemplate() bool {
+	l.advance() // Eat opening '`' ---
+
+	for !l.isEOF() && l.peek() != '`' {
+		switch l.peek() {
+		case '\\':
+			l.advance()
+		case '$':
+			if l.peekNext() == '{' {
+				l.advance() // Eat '$' ---
+				l.advance() // Eat '{' ---
+				if !l.skipTemplateExpression() {
+					return false
+				}
+			}
+		case '\n':
+			l.Line++
+		}
+		l.advance()
+	}
+	return l.match('`')
 }
 
 func (l *Lexer) handleString(char rune) {
+	var builder strings.Builder
+
 	for !l.isEOF() && l.peek() != char /* closing char string */ {
 		if l.peek() == '\n' {
 			break
 		}
-		l.advance()
+
+		if l.peek() == '\\' {
+			if !l.handleEscape(&builder) {
+				return
+			}
+			continue
+		}
+
+		builder.WriteRune(l.advance())
 	}
 
 	if l.isEOF() || l.peek() == '\n' {
@@ -158,8 +321,150 @@ func (l *Lexer) handleString(char rune) {
 
 	l.match(char)
 
-	literal := string(l.SourceCode[l.Start +1 : l.Current -1])
-	l.addTokenWithLiteral(STRING, literal, 0)
+	l.addTokenWithLiteral(STRING, builder.String(), 0)
+}
+
+// Raw strings (r"..." or r'...') keep every character as written ---
+func (l *Lexer) handleRawString() {
+	if literal, ok := l.readPrefixedText("raw string"); ok {
+		l.addTokenWithLiteral(STRING, literal, 0)
+	}
+}
+
+// Decimal literals (d"19.99") keep their digits as text so no precision
+// is lost on the way to the interpreter ---
+func (l *Lexer) handleDecimal() {
+	literal, ok := l.readPrefixedText("decimal literal")
+	if !ok {
+		return
+	}
+
+	digits := strings.ReplaceAll(literal, "_", "")
+	if !isDecimalText(digits) {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Invalid decimal literal d\"%s\"", literal),
+			l.Line,
+			errorhandler.ExpectedTypeError,
+		)
+		return
+	}
+
+	l.addTokenWithLiteral(DECIMAL, digits, 0)
+}
+
+// Reads the quoted text after a one-letter prefix, without escapes ---
+func (l *Lexer) readPrefixedText(kind string) (string, bool) {
+	char := l.advance() // Eat opening quote ---
+
+	for !l.isEOF() && l.peek() != char {
+		if l.peek() == '\n' {
+			break
+		}
+		l.advance()
+	}
+
+	if l.isEOF() || l.peek() == '\n' {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Unterminated %s", kind),
+			l.Line,
+			errorhandler.UnterminatedError,
+		)
+		return "", false
+	}
+
+	l.match(char)
+
+	return string(l.SourceCode[l.Start+2 : l.Current-1]), true
+}
+
+// Consumes an escape sequence starting at '\\' and writes the decoded
+// character, reports an error and returns false when it is invalid ---
+func (l *Lexer) handleEscape(builder *strings.Builder) bool {
+	l.advance() // Eat '\\' ---
+
+	if l.isEOF() {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			"Unterminated escape sequence",
+			l.Line,
+			errorhandler.UnterminatedError,
+		)
+		return false
+	}
+
+	char := l.advance()
+	switch char {
+	case 'n':
+		builder.WriteRune('\n')
+	case 't':
+		builder.WriteRune('\t')
+	case 'r':
+		builder.WriteRune('\r')
+	case '0':
+		builder.WriteRune(0)
+	case '\\', '"', '\'', '`', '$':
+		builder.WriteRune(char)
+	case '\n':
+		// A backslash before a newline continues the string on the next line ---
+		l.Line++
+	case 'u':
+		return l.handleUnicodeEscape(builder)
+	default:
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Invalid escape sequence '\\%c'", char),
+			l.Line,
+			errorhandler.InvalidEscapeError,
+		)
+		return false
+	}
+
+	return true
+}
+
+// Handles '\\u{XXXX}' with one to six hexadecimal digits ---
+func (l *Lexer) handleUnicodeEscape(builder *strings.Builder) bool {
+	if !l.match('{') {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			"Invalid unicode escape, expected '\\u{...}'",
+			l.Line,
+			errorhandler.InvalidEscapeError,
+		)
+		return false
+	}
+
+	start := l.Current
+	for !l.isEOF() && isHexDigit(l.peek()) {
+		l.advance()
+	}
+	digits := string(l.SourceCode[start:l.Current])
+
+	if !l.match('}') || len(digits) == 0 || len(digits) > 6 {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			"Invalid unicode escape, expected one to six hexadecimal digits inside '\\u{...}'",
+			l.Line,
+			errorhandler.InvalidEscapeError,
+		)
+		return false
+	}
+
+	codePoint, _ := strconv.ParseUint(digits, 16, 32)
+	if codePoint > unicode.MaxRune || (codePoint >= 0xD800 && codePoint <= 0xDFFF) {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Invalid unicode code point '\\u{%s}'", digits),
+			l.Line,
+			errorhandler.InvalidEscapeError,
+		)
+		return false
+	}
+
+	builder.WriteRune(rune(codePoint))
+	return true
 }
 
 func (l *Lexer) handleSlash() {
@@ -192,13 +497,49 @@ func (l *Lexer) handleIdentifier() {
 
 	value := string(l.SourceCode[l.Start:l.Current])
 	keyword, exists := RESERVED_KEYWORDS[value]
-	if exists {
+	if value == "_" {
+		l.addToken(UNDERSCORE) // A lone '_' is the wildcard ---
+	} else if exists {
 		l.addToken(keyword)
 	} else {
 		l.addTokenWithLiteral(IDENTIFIER, value, 0)
 	}
 }
 
+func (l *Lexer) handleDot() {
+	if l.peek() == '.' && l.peekNext() == '.' {
+		l.advance() // Eat '.' token ---
+		l.advance() // Eat '.' token ---
+		l.addToken(ELLIPSIS)
+	} else if l.peek() == '.' {
+		l.advance() // Eat '.' token ---
+		l.handleCompound(DOT_DOT, DOT_DOT_EQUALS)
+	} else {
+		l.addToken(DOT)
+	}
+}
+
+func (l *Lexer) handleEquals() {
+	if l.peek() == '>' {
+		l.advance() // Eat '>' token ---
+		l.addToken(ARROW)
+	} else {
+		l.handleCompound(ASSIGNMENT, EQUAL)
+	}
+}
+
+func (l *Lexer) handleQuestion() {
+	if l.peek() == '?' {
+		l.advance() // Eat '?' token ---
+		l.handleCompound(NULLISH_COALESCING, NULLISH_COALESCING_EQUALS)
+	} else if l.peek() == '.' {
+		l.advance() // Eat '.' token ---
+		l.addToken(QUESTION_DOT)
+	} else {
+		l.addToken(QUESTION)
+	}
+}
+
 func (l *Lexer) handleMinus() {
 	if l.peek() == '-' {
 		l.advance() // Eat '-' token ---
@@ -208,6 +549,54 @@ func (l *Lexer) handleMinus() {
 	}
 }
 
+func (l *Lexer) handleStar() {
+	if l.peek() == '*' {
+		l.advance() // Eat '*' token ---
+		l.addToken(STAR_STAR)
+	} else {
+		l.handleCompound(STAR, STAR_EQUALS)
+	}
+}
+
+// `~/` divides and floors, a lone `~` flips bits ---
+func (l *Lexer) handleTilde() {
+	if l.peek() == '/' {
+		l.advance() // Eat '/' token ---
+		l.addToken(TILDE_SLASH)
+	} else {
+		l.addToken(TILDE)
+	}
+}
+
+// `#{` opens a map literal and `#[` a set literal ---
+func (l *Lexer) handleHash() {
+	switch l.peek() {
+	case '{':
+		l.advance() // Eat '{' token ---
+		l.addToken(HASH_BRACE)
+	case '[':
+		l.advance() // Eat '[' token ---
+		l.addToken(HASH_BRACKET)
+	default:
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			"Expected '{' or '[' after '#'",
+			l.Line,
+			errorhandler.UnknownTokenError,
+		)
+	}
+}
+
+// `<`, `<=` and `<<` (likewise for '>') ---
+func (l *Lexer) handleAngle(char rune, regular, compound, shift TokenType) {
+	if l.peek() == char {
+		l.advance() // Eat second angle ---
+		l.addToken(shift)
+	} else {
+		l.handleCompound(regular, compound)
+	}
+}
+
 func (l *Lexer) handlePlus() {
 	if l.peek() == '+' {
 		l.advance() // Eat '+' token ---
@@ -227,23 +616,29 @@ func (l *Lexer) handleCompound(regular, compound TokenType) {
 }
 
 func (l *Lexer) handleNumbers() {
-	for isNumber(l.peek()) || l.peek() == '_' {
-		if l.peek() == '_' {
-			if !isNumber(l.peekNext()) {
-				l.ErrorHandler.ReportError(
-					"Lexer-Tokenizer",
-					"Numeric separator '_' must be between digits",
-					l.Line,
-					errorhandler.ExpectedTypeError,
-				)
-				return
-			}
+	// 0x / 0o / 0b prefixed integers ---
+	if l.SourceCode[l.Start] == '0' {
+		switch l.peek() {
+		case 'x', 'X':
+			l.handlePrefixedInteger(16, isHexDigit)
+			return
+		case 'o', 'O':
+			l.handlePrefixedInteger(8, isOctalDigit)
+			return
+		case 'b', 'B':
+			l.handlePrefixedInteger(2, isBinaryDigit)
+			return
 		}
-		l.advance()
 	}
 
-	// Handle floats
-	if l.peek() == '.' {
+	if !l.consumeDigits(isNumber) {
+		return
+	}
+
+	isFloat := false
+
+	// Handle floats, `1..5` is a range instead ---
+	if l.peek() == '.' && l.peekNext() != '.' {
 		if !isNumber(l.peekNext()) {
 			l.ErrorHandler.ReportError(
 				"Lexer-Tokenizer",
@@ -255,31 +650,47 @@ func (l *Lexer) handleNumbers() {
 		}
 
 		l.advance() // eat '.'
+		if !l.consumeDigits(isNumber) {
+			return
+		}
+		isFloat = true
+	}
 
-		// Parse decimal part (with underscores)
-		for isNumber(l.peek()) || l.peek() == '_' {
-			if l.peek() == '_' {
-				if !isNumber(l.peekNext()) {
-					l.ErrorHandler.ReportError(
-						"Lexer-Tokenizer",
-						"Numeric separator '_' must be between digits",
-						l.Line,
-						errorhandler.ExpectedTypeError,
-					)
-					return
-				}
-			}
+	// Scientific notation: 1.5e-9, 2E10 ---
+	if l.peek() == 'e' || l.peek() == 'E' {
+		sign := l.peekNext()
+		digitAt := l.Current + 1
+		if sign == '+' || sign == '-' {
+			digitAt++
+		}
+
+		if digitAt >= len(l.SourceCode) || !isNumber(l.SourceCode[digitAt]) {
+			l.ErrorHandler.ReportError(
+				"Lexer-Tokenizer",
+				fmt.Sprintf("Expected digits after exponent '%s'", string(l.SourceCode[l.Current:digitAt])),
+				l.Line,
+				errorhandler.ExpectedTypeError,
+			)
+			return
+		}
+
+		l.advance() // eat 'e' ---
+		if sign == '+' || sign == '-' {
 			l.advance()
 		}
+		if !l.consumeDigits(isNumber) {
+			return
+		}
+		isFloat = true
 	}
 
 	// Remove underscores before parsing
 	rawValue := string(l.SourceCode[l.Start:l.Current])
-	cleanValue := ""
-	for _, char := range rawValue {
-		if char != '_' {
-			cleanValue += string(char)
-		}
+	cleanValue := strings.ReplaceAll(rawValue, "_", "")
+
+	if !isFloat {
+		l.addIntegerToken(cleanValue, 10, rawValue)
+		return
 	}
 
 	parsedNumber, error := strconv.ParseFloat(cleanValue, 64)
@@ -294,6 +705,79 @@ func (l *Lexer) handleNumbers() {
 	l.addTokenWithLiteral(NUMBER, parsedNumber, 0)
 }
 
+func (l *Lexer) handlePrefixedInteger(base int, isDigit func(rune) bool) {
+	l.advance() // Eat the base letter ---
+
+	if !isDigit(l.peek()) && !(l.peek() == '_' && isDigit(l.peekNext())) {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Expected base %d digits after '%s'", base, string(l.SourceCode[l.Start:l.Current])),
+			l.Line,
+			errorhandler.ExpectedTypeError,
+		)
+		return
+	}
+
+	if !l.consumeDigits(isDigit) {
+		return
+	}
+
+	// A digit of a larger base right after (0b102, 0o78) is a typo ---
+	if isAlphanumeric(l.peek()) && l.peek() != 'n' {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Invalid digit '%c' in base %d literal", l.peek(), base),
+			l.Line,
+			errorhandler.ExpectedTypeError,
+		)
+		return
+	}
+
+	rawValue := string(l.SourceCode[l.Start:l.Current])
+	digits := strings.ReplaceAll(rawValue[2:], "_", "")
+	l.addIntegerToken(digits, base, rawValue)
+}
+
+// Integers carry an int64 literal, or a base 10 string for `123n` bigints ---
+func (l *Lexer) addIntegerToken(digits string, base int, rawValue string) {
+	if l.peek() == 'n' && !isAlphanumeric(l.peekNext()) {
+		l.advance() // Eat 'n' ---
+		value, _ := new(big.Int).SetString(digits, base)
+		l.addTokenWithLiteral(BIGINT, value.String(), 0)
+		return
+	}
+
+	value, err := strconv.ParseInt(digits, base, 64)
+	if err != nil {
+		l.ErrorHandler.ReportError(
+			"Lexer-Tokenizer",
+			fmt.Sprintf("Integer literal %s is out of range, add an 'n' suffix for a bigint", rawValue),
+			l.Line,
+			errorhandler.ExpectedTypeError,
+		)
+		return
+	}
+
+	l.addTokenWithLiteral(NUMBER, value, 0)
+}
+
+// Consumes a run of digits, allowing '_' separators between them ---
+func (l *Lexer) consumeDigits(isDigit func(rune) bool) bool {
+	for isDigit(l.peek()) || l.peek() == '_' {
+		if l.peek() == '_' && !isDigit(l.peekNext()) {
+			l.ErrorHandler.ReportError(
+				"Lexer-Tokenizer",
+				"Numeric separator '_' must be between digits",
+				l.Line,
+				errorhandler.ExpectedTypeError,
+			)
+			return false
+		}
+		l.advance()
+	}
+	return true
+}
+
 func (l *Lexer) advance() rune {
 	token := l.SourceCode[l.Current]
 	l.Current++
@@ -352,6 +836,34 @@ func isNumber(char rune) bool {
 	return char >= '0' && char <= '9'
 }
 
+func isOctalDigit(char rune) bool {
+	return char >= '0' && char <= '7'
+}
+
+func isBinaryDigit(char rune) bool {
+	return char == '0' || char == '1'
+}
+
+func isHexDigit(char rune) bool {
+	return isNumber(char) || (char >= 'a' && char <= 'f') || (char >= 'A' && char <= 'F')
+}
+
+// An optional sign, digits and an optional fraction ---
+func isDecimalText(text string) bool {
+	text = strings.TrimPrefix(strings.TrimPrefix(text, "-"), "+")
+	whole, fraction, hasFraction := strings.Cut(text, ".")
+	if whole == "" || (hasFraction && fraction == "") {
+		return false
+	}
+
+	for _, char := range whole + fraction {
+		if !isNumber(char) {
+			return false
+		}
+	}
+	return true
+}
+
 func isAlphabet(char rune) bool {
 	return (char >= 'a' && char <= 'z') ||
         (char >= 'A' && char <= 'Z')
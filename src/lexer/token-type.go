@@ -6,7 +6,10 @@ const (
 	EOF TokenType = iota
 
 	STRING
+	TEMPLATE
 	NUMBER
+	BIGINT
+	DECIMAL
 	IDENTIFIER
 
 	// SINGLE-CHARS TOKEN ---
@@ -20,6 +23,11 @@ const (
 	SEMICOLON
 	COLON
 	UNDERSCORE
+	QUESTION
+	PIPE
+	AMPERSAND
+	CARET
+	TILDE
 
 	ASSIGNMENT
 	PLUS
@@ -46,6 +54,19 @@ const (
 	STAR_EQUALS
 	SLASH_EQUALS
 	MODULO_EQUALS
+	STAR_STAR
+	TILDE_SLASH
+	SHIFT_LEFT
+	SHIFT_RIGHT
+	ARROW
+	DOT_DOT
+	DOT_DOT_EQUALS
+	ELLIPSIS
+	NULLISH_COALESCING
+	NULLISH_COALESCING_EQUALS
+	QUESTION_DOT
+	HASH_BRACE   // `#{`, opens a map literal ---
+	HASH_BRACKET // `#[`, opens a set literal ---
 
 	// RESERVED KEYWORDS ---
 	VARIABLE
@@ -63,30 +84,67 @@ const (
 	RETURN
 	CONTINUE
 	OF
+	CLASS
+	EXTENDS
+	THIS
+	SUPER
+	TRY
+	CATCH
+	FINALLY
+	THROW
+	IMPORT
+	EXPORT
+	FROM
+	AS
+	MATCH
+	ENUM
+	YIELD
+	SPAWN
+	SELECT
 )
 
 var RESERVED_KEYWORDS = map[string]TokenType{
-	"var":   VARIABLE,
-	"const": CONSTANT,
-	"not":   NOT,
-	"and":   AND,
-	"or":    OR,
-	"if":    IF,
-	"else":  ELSE,
-	"fn":    FUNCTION,
-	"while": WHILE,
-	"for": FOR,
-	"range": RANGE,
-	"of": OF,
-	"break": BREAK,
-	"return": RETURN,
+	"var":      VARIABLE,
+	"const":    CONSTANT,
+	"not":      NOT,
+	"and":      AND,
+	"or":       OR,
+	"if":       IF,
+	"else":     ELSE,
+	"fn":       FUNCTION,
+	"while":    WHILE,
+	"for":      FOR,
+	"range":    RANGE,
+	"of":       OF,
+	"break":    BREAK,
+	"return":   RETURN,
 	"continue": CONTINUE,
+	"class":    CLASS,
+	"extends":  EXTENDS,
+	"this":     THIS,
+	"super":    SUPER,
+	"try":      TRY,
+	"catch":    CATCH,
+	"finally":  FINALLY,
+	"throw":    THROW,
+	"import":   IMPORT,
+	"export":   EXPORT,
+	"from":     FROM,
+	"as":       AS,
+	"match":    MATCH,
+	"enum":     ENUM,
+	"yield":    YIELD,
+	"spawn":    SPAWN,
+	"select":   SELECT,
 }
 
 var TokenTypeString = map[TokenType]string{
 	EOF:        "EOF",
 	STRING:     "STRING",
+	TEMPLATE:   "TEMPLATE",
 	NUMBER:     "NUMBER",
+	BIGINT:     "BIGINT",
+	DECIMAL:    "DECIMAL",
 	IDENTIFIER: "IDENTIFIER",
 
 	LEFT_BRACE:        "LEFT_BRACE",
@@ -99,6 +157,11 @@ var TokenTypeString = map[TokenType]string{
 	SEMICOLON:         "SEMICOLON",
 	COLON:             "COLON",
 	UNDERSCORE:        "UNDERSCORE",
+	PIPE:              "PIPE",
+	AMPERSAND:         "AMPERSAND",
+	CARET:             "CARET",
+	TILDE:             "TILDE",
+	QUESTION:          "QUESTION",
 
 	ASSIGNMENT: "ASSIGNMENT",
 	PLUS:       "PLUS",
@@ -108,19 +171,36 @@ var TokenTypeString = map[TokenType]string{
 	MODULO:     "MODULO",
 	DOT:        "DOT",
 
-	NOT: "NOT",
-	AND: "AND",
-	OR:  "OR",
-	ELSE: "ELSE",
-	IF:  "IF",
-	FUNCTION:  "FUNCTION",
-	WHILE: "WHILE",
-	FOR: "FOR",
-	RANGE: "RANGE",
-	OF: "OF",
-	BREAK: "BREAK",
-	RETURN: "RETURN",
+	NOT:      "NOT",
+	AND:      "AND",
+	OR:       "OR",
+	ELSE:     "ELSE",
+	IF:       "IF",
+	FUNCTION: "FUNCTION",
+	WHILE:    "WHILE",
+	FOR:      "FOR",
+	RANGE:    "RANGE",
+	OF:       "OF",
+	BREAK:    "BREAK",
+	RETURN:   "RETURN",
 	CONTINUE: "CONTINUE",
+	CLASS:    "CLASS",
+	EXTENDS:  "EXTENDS",
+	THIS:     "THIS",
+	SUPER:    "SUPER",
+	TRY:      "TRY",
+	CATCH:    "CATCH",
+	FINALLY:  "FINALLY",
+	THROW:    "THROW",
+	IMPORT:   "IMPORT",
+	EXPORT:   "EXPORT",
+	FROM:     "FROM",
+	AS:       "AS",
+	MATCH:    "MATCH",
+	ENUM:     "ENUM",
+	YIELD:    "YIELD",
+	SPAWN:    "SPAWN",
+	SELECT:   "SELECT",
 
 	LESS:          "LESS",
 	LESS_EQUAL:    "LESS_EQUAL",
@@ -136,6 +216,20 @@ var TokenTypeString = map[TokenType]string{
 	STAR_EQUALS:   "STAR_EQUALS",
 	SLASH_EQUALS:  "SLASH_EQUALS",
 	MODULO_EQUALS: "MODULO_EQUALS",
+	STAR_STAR:     "STAR_STAR",
+	TILDE_SLASH:   "TILDE_SLASH",
+	SHIFT_LEFT:    "SHIFT_LEFT",
+	SHIFT_RIGHT:   "SHIFT_RIGHT",
+	ARROW:         "ARROW",
+	DOT_DOT: "DOT_DOT",
+	DOT_DOT_EQUALS: "DOT_DOT_EQUALS",
+	ELLIPSIS:      "ELLIPSIS",
+
+	NULLISH_COALESCING:        "NULLISH_COALESCING",
+	NULLISH_COALESCING_EQUALS: "NULLISH_COALESCING_EQUALS",
+	QUESTION_DOT:              "QUESTION_DOT",
+	HASH_BRACE:                "HASH_BRACE",
+	HASH_BRACKET:              "HASH_BRACKET",
 
 	VARIABLE: "VARIABLE",
 	CONSTANT: "CONSTANT",
@@ -4,10 +4,17 @@ import "fmt"
 
 type Token struct {
 	TokenType TokenType
-	Lexeme string
-	Literal any
-	Line uint
-} 
+	Lexeme    string
+	Literal   any
+	Line      uint
+}
+
+// Literal of a TEMPLATE token: text segments alternate with the
+// tokens of each embedded `${expression}` (Tokens is nil for text) ---
+type TemplatePart struct {
+	Text   string
+	Tokens []*Token
+}
 
 func NewToken(TokenType TokenType, Lexeme string, Literal any, Line uint) *Token {
 	return &Token{
@@ -19,5 +26,5 @@ func NewToken(TokenType TokenType, Lexeme string, Literal any, Line uint) *Token
 }
 
 func (t *Token) String() {
-	fmt.Printf("\nTokenType: %v\nLexeme: %s\nLiteral: %v\nLine: %d\n", TokenTypeString[t.TokenType], t.Lexeme, t.Literal,t.Line)
+	fmt.Printf("\nTokenType: %v\nLexeme: %s\nLiteral: %v\nLine: %d\n", TokenTypeString[t.TokenType], t.Lexeme, t.Literal, t.Line)
 }
@@ -2,7 +2,6 @@ package parser
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/caelondev/lento/src/ast"
 	errorhandler "github.com/caelondev/lento/src/error-handler"
@@ -70,26 +69,48 @@ func parseExpression(p *parser, bp BindingPower) ast.Expression {
 func parsePrimaryExpression(p *parser) ast.Expression {
 	switch p.currentTokenType() {
 	case lexer.NUMBER:
-		number, err := strconv.ParseFloat(p.advance().Lexeme, 64)
-		if err != nil {
-			p.errorHandler.Report(p.line, fmt.Sprintf("An Error occured whilst trying to parse a number:\n%s", err))
+		// The lexer has already parsed the literal ---
+		if integer, isInteger := p.currentToken().Literal.(int64); isInteger {
+			p.advance()
+			return &ast.IntegerExpression{
+				Value: integer,
+				Line:  p.line,
+			}
 		}
 
 		return &ast.NumberExpression{
-			Value: number,
+			Value: p.advance().Literal.(float64),
+			Line:  p.line,
+		}
+	case lexer.BIGINT:
+		return &ast.BigIntExpression{
+			Value: p.advance().Literal.(string),
+			Line:  p.line,
+		}
+	case lexer.DECIMAL:
+		return &ast.DecimalExpression{
+			Value: p.advance().Literal.(string),
 			Line:  p.line,
 		}
 	case lexer.STRING:
 		return &ast.StringExpression{
-			Value: p.advance().Lexeme,
+			Value: p.advance().Literal.(string),
 			Line:  p.line,
 		}
 	case lexer.IDENTIFIER:
+		if p.peekTokenType(1) == lexer.ARROW {
+			return parseArrowFunctionExpression(p)
+		}
+
 		return &ast.SymbolExpression{
 			Value: p.advance().Lexeme,
 			Line:  p.line,
 		}
 	case lexer.LEFT_PARENTHESIS:
+		if p.isArrowFunction() {
+			return parseArrowFunctionExpression(p)
+		}
+
 		p.advance() // Eat '(' ---
 		value := parseExpression(p, DEFAULT_BP)
 		p.expect(lexer.RIGHT_PARENTHESIS)
@@ -119,9 +140,28 @@ func parseBinaryExpression(p *parser, left ast.Expression, bp BindingPower) ast.
 	}
 }
 
+// `**` is right-associative: 2 ** 3 ** 2 is 2 ** (3 ** 2) ---
+func parseExponentExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
+	operatorToken := p.advance() // Eat '**' ---
+	right := parseExpression(p, bp-1)
+
+	return &ast.BinaryExpression{
+		Left:     left,
+		Right:    right,
+		Operator: operatorToken,
+		Line:     p.line,
+	}
+}
+
 func parseUnaryExpression(p *parser) ast.Expression {
 	operatorToken := p.advance()
-	value := parseExpression(p, UNARY)
+
+	operandBP := UNARY
+	if operatorToken.TokenType == lexer.NOT {
+		operandBP = LOGICAL_AND // `not a == b` negates the whole comparison ---
+	}
+
+	value := parseExpression(p, operandBP)
 	return &ast.UnaryExpression{
 		Operator: operatorToken,
 		Operand:  value,
@@ -129,18 +169,44 @@ func parseUnaryExpression(p *parser) ast.Expression {
 	}
 }
 
+func parseTernaryExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
+	p.advance() // Eat '?' ---
+
+	consequent := parseExpression(p, DEFAULT_BP)
+	p.expect(lexer.COLON)
+	alternate := parseExpression(p, TERNARY-1) // Right associative ---
+
+	return &ast.TernaryExpression{
+		Condition:  left,
+		Consequent: consequent,
+		Alternate:  alternate,
+		Line:       p.line,
+	}
+}
+
 func parseAssignmentExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
 	operator := p.advance().TokenType
 	value := parseExpression(p, ASSIGNMENT-1)
 
+	// [a, b] = [b, a]; / { x, y } = point; ---
+	var pattern ast.Pattern
+	switch left.(type) {
+	case *ast.ArrayExpression, *ast.ObjectExpression:
+		if operator == lexer.ASSIGNMENT {
+			pattern = expressionToPattern(p, left)
+		}
+	}
+
 	return &ast.AssignmentExpression{
 		Operator: operator,
 		Assignee: left,
+		Pattern:  pattern,
 		Value:    value,
 	}
 }
 
 func parseCallExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
+	optional := eatQuestionDot(p)
 	p.advance() // Eat '(' ---
 
 	arguments := make([]ast.Expression, 0)
@@ -148,7 +214,7 @@ func parseCallExpression(p *parser, left ast.Expression, bp BindingPower) ast.Ex
 	// Parse arguments (comma-separated expressions)
 	if p.currentTokenType() != lexer.RIGHT_PARENTHESIS {
 		// Parse first argument
-		arg := parseExpression(p, DEFAULT_BP)
+		arg := parseArgument(p)
 		if arg != nil {
 			arguments = append(arguments, arg)
 		}
@@ -156,18 +222,35 @@ func parseCallExpression(p *parser, left ast.Expression, bp BindingPower) ast.Ex
 		// Parse remaining arguments
 		for p.currentTokenType() == lexer.COMMA {
 			p.advance() // eat comma
-			arg := parseExpression(p, DEFAULT_BP)
+			arg := parseArgument(p)
 			if arg != nil {
 				arguments = append(arguments, arg)
 			}
 		}
 	}
 
+	// Named arguments must come after every positional one ---
+	seenNamed := false
+	for _, arg := range arguments {
+		if _, isNamed := arg.(*ast.NamedArgumentExpression); isNamed {
+			seenNamed = true
+		} else if seenNamed {
+			p.errorHandler.ReportError(
+				"Parser-Call",
+				"Positional arguments cannot follow named arguments",
+				p.line,
+				errorhandler.UnexpectedTokenError,
+			)
+			return nil
+		}
+	}
+
 	p.expect(lexer.RIGHT_PARENTHESIS)
 
 	return &ast.CallExpression{
 		Caller:    left,
 		Arguments: arguments,
+		Optional:  optional,
 		Line:      p.line,
 	}
 }
@@ -178,7 +261,7 @@ func parseArrayExpression(p *parser) ast.Expression {
 	p.advance() // Eat LEFT_BRACKET token ---
 
 	if p.currentTokenType() != lexer.RIGHT_BRACKET {
-		element := parseExpression(p, DEFAULT_BP)
+		element := parseElement(p)
 		if element != nil {
 			elements = append(elements, element)
 		}
@@ -186,7 +269,7 @@ func parseArrayExpression(p *parser) ast.Expression {
 		for p.currentTokenType() == lexer.COMMA {
 			p.advance() // Eat COMMA ---
 
-			element := parseExpression(p, DEFAULT_BP)
+			element := parseElement(p)
 			if element != nil {
 				elements = append(elements, element)
 			}
@@ -201,17 +284,82 @@ func parseArrayExpression(p *parser) ast.Expression {
 	}
 }
 
+// A call argument, which may be named (`port: 8080`) or spread ---
+func parseArgument(p *parser) ast.Expression {
+	if p.currentTokenType() != lexer.IDENTIFIER || p.peekTokenType(1) != lexer.COLON {
+		return parseElement(p)
+	}
+
+	name := p.advance().Lexeme
+	p.advance() // Eat ':' ---
+
+	return &ast.NamedArgumentExpression{
+		Name:  name,
+		Value: parseExpression(p, DEFAULT_BP),
+		Line:  p.line,
+	}
+}
+
+// An array element or call argument, which may be spread with `...` ---
+func parseElement(p *parser) ast.Expression {
+	if p.currentTokenType() != lexer.ELLIPSIS {
+		return parseExpression(p, DEFAULT_BP)
+	}
+
+	p.advance() // Eat '...' ---
+
+	return &ast.SpreadExpression{
+		Value: parseExpression(p, DEFAULT_BP),
+		Line:  p.line,
+	}
+}
+
 func parseIndexExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
-	p.advance() // Eat LEFT_PARENTHESIS ---
-	index := parseExpression(p, DEFAULT_BP)
+	optional := eatQuestionDot(p)
+	p.advance() // Eat LEFT_BRACKET ---
+
+	var index ast.Expression
+	if p.currentTokenType() != lexer.COLON {
+		index = parseExpression(p, DEFAULT_BP)
+	}
+
+	if p.currentTokenType() == lexer.COLON {
+		return parseSliceExpression(p, left, index, optional)
+	}
 
 	p.expect(lexer.RIGHT_BRACKET)
 
 	return &ast.IndexExpression{
-		Expr:  left,
-		Index: index,
-		Line:  p.line,
+		Expr:     left,
+		Index:    index,
+		Optional: optional,
+		Line:     p.line,
+	}
+}
+
+func parseSliceExpression(p *parser, left ast.Expression, start ast.Expression, optional bool) ast.Expression {
+	// SYNTAX ---
+	// target[start:end]
+	// target[start:end:step]
+	//
+
+	slice := &ast.SliceExpression{Expr: left, Start: start, Optional: optional}
+
+	p.advance() // Eat ':' ---
+	if p.currentTokenType() != lexer.COLON && p.currentTokenType() != lexer.RIGHT_BRACKET {
+		slice.End = parseExpression(p, DEFAULT_BP)
+	}
+
+	if p.currentTokenType() == lexer.COLON {
+		p.advance() // Eat ':' ---
+		if p.currentTokenType() != lexer.RIGHT_BRACKET {
+			slice.Step = parseExpression(p, DEFAULT_BP)
+		}
 	}
+
+	p.expect(lexer.RIGHT_BRACKET)
+	slice.Line = p.line
+	return slice
 }
 
 func parseObjectExpression(p *parser) ast.Expression {
@@ -221,14 +369,7 @@ func parseObjectExpression(p *parser) ast.Expression {
 
 	if p.currentTokenType() != lexer.RIGHT_BRACE {
 		// Parse first property
-		key := p.expect(lexer.IDENTIFIER).Lexeme
-		p.expect(lexer.COLON)
-		value := parseExpression(p, DEFAULT_BP)
-
-		properties = append(properties, ast.ObjectProperty{
-			Key:   key,
-			Value: value,
-		})
+		properties = append(properties, parseObjectProperty(p))
 
 		// Parse remaining properties
 		for p.currentTokenType() != lexer.RIGHT_BRACE {
@@ -241,14 +382,7 @@ func parseObjectExpression(p *parser) ast.Expression {
 			}
 
 			// We got comma, parse next property
-			key := p.expect(lexer.IDENTIFIER).Lexeme
-			p.expect(lexer.COLON)
-			value := parseExpression(p, DEFAULT_BP)
-
-			properties = append(properties, ast.ObjectProperty{
-				Key:   key,
-				Value: value,
-			})
+			properties = append(properties, parseObjectProperty(p))
 		}
 	}
 
@@ -260,17 +394,105 @@ func parseObjectExpression(p *parser) ast.Expression {
 	}
 }
 
+func parseMapExpression(p *parser) ast.Expression {
+	var entries []ast.MapEntry
+
+	p.advance() // Eat HASH_BRACE ---
+
+	for p.currentTokenType() != lexer.RIGHT_BRACE && p.currentTokenType() != lexer.EOF {
+		key := parseExpression(p, DEFAULT_BP)
+		p.expect(lexer.COLON)
+		entries = append(entries, ast.MapEntry{Key: key, Value: parseExpression(p, DEFAULT_BP)})
+
+		if p.currentTokenType() != lexer.RIGHT_BRACE {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return &ast.MapExpression{
+		Entries: entries,
+		Line:    p.line,
+	}
+}
+
+func parseSetExpression(p *parser) ast.Expression {
+	var elements []ast.Expression
+
+	p.advance() // Eat HASH_BRACKET ---
+
+	for p.currentTokenType() != lexer.RIGHT_BRACKET && p.currentTokenType() != lexer.EOF {
+		elements = append(elements, parseElement(p))
+
+		if p.currentTokenType() != lexer.RIGHT_BRACKET {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACKET)
+
+	return &ast.SetExpression{
+		Elements: elements,
+		Line:     p.line,
+	}
+}
+
+func parseObjectProperty(p *parser) ast.ObjectProperty {
+	if p.currentTokenType() == lexer.ELLIPSIS {
+		return ast.ObjectProperty{Value: parseElement(p)}
+	}
+
+	keyToken := p.expect(lexer.IDENTIFIER)
+
+	// Shorthand `{ name }` is the same as `{ name: name }` ---
+	if p.currentTokenType() != lexer.COLON {
+		return ast.ObjectProperty{
+			Key:   keyToken.Lexeme,
+			Value: &ast.SymbolExpression{Value: keyToken.Lexeme, Line: p.line},
+		}
+	}
+
+	p.expect(lexer.COLON)
+
+	return ast.ObjectProperty{
+		Key:   keyToken.Lexeme,
+		Value: parseExpression(p, DEFAULT_BP),
+	}
+}
+
 func parseMemberExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
-	p.advance() // eat '.' ---
+	optional := p.advance().TokenType == lexer.QUESTION_DOT // eat '.' or '?.' ---
 	property := p.expect(lexer.IDENTIFIER).Lexeme
 
 	return &ast.MemberExpression{
 		Object:   left,
 		Property: property,
+		Optional: optional,
 		Line:     p.line,
 	}
 }
 
+// `?.` leads a member access, an index (`a?.[k]`) or a call (`f?.(x)`) ---
+func parseOptionalChainExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
+	switch p.peekTokenType(1) {
+	case lexer.LEFT_BRACKET:
+		return parseIndexExpression(p, left, bp)
+	case lexer.LEFT_PARENTHESIS:
+		return parseCallExpression(p, left, bp)
+	default:
+		return parseMemberExpression(p, left, bp)
+	}
+}
+
+func eatQuestionDot(p *parser) bool {
+	if p.currentTokenType() != lexer.QUESTION_DOT {
+		return false
+	}
+	p.advance() // Eat '?.' ---
+	return true
+}
+
 func parsePostfixExpression(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
 	return &ast.PostfixExpression{
 		Operand:  left,
@@ -278,3 +500,283 @@ func parsePostfixExpression(p *parser, left ast.Expression, bp BindingPower) ast
 		Line:     p.line,
 	}
 }
+
+func parseFunctionExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// fn (params) { ... }
+	// fn (params) expression
+	// fn* (params) { ... yield value; ... }
+	//
+
+	p.advance() // Eat 'fn' ---
+	isGenerator := parseGeneratorMarker(p)
+
+	parameters := parseParameters(p)
+
+	return &ast.FunctionExpression{
+		Parameters:  parameters,
+		Body:        parseLambdaBody(p),
+		IsGenerator: isGenerator,
+		Line:        p.line,
+	}
+}
+
+// Eats the '*' of `fn*` and reports whether it was there ---
+func parseGeneratorMarker(p *parser) bool {
+	if p.currentTokenType() != lexer.STAR {
+		return false
+	}
+
+	p.advance()
+	return true
+}
+
+func parseYieldExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// yield
+	// yield expression
+	//
+
+	p.advance() // Eat 'yield' ---
+	line := p.line
+
+	switch p.currentTokenType() {
+	case lexer.SEMICOLON, lexer.COMMA, lexer.COLON, lexer.RIGHT_PARENTHESIS,
+		lexer.RIGHT_BRACKET, lexer.RIGHT_BRACE, lexer.EOF:
+		return &ast.YieldExpression{Line: line}
+	}
+
+	return &ast.YieldExpression{
+		Value: parseExpression(p, ASSIGNMENT),
+		Line:  line,
+	}
+}
+
+func parseArrowFunctionExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// (params) => { ... }
+	// (params) => expression
+	// param => expression
+	//
+
+	var parameters []ast.Pattern
+
+	if p.currentTokenType() == lexer.IDENTIFIER {
+		parameters = append(parameters, &ast.IdentifierPattern{
+			Name: p.advance().Lexeme,
+			Line: p.line,
+		})
+	} else {
+		parameters = parseParameters(p)
+	}
+
+	p.expect(lexer.ARROW)
+
+	return &ast.FunctionExpression{
+		Parameters: parameters,
+		Body:       parseLambdaBody(p),
+		Line:       p.line,
+	}
+}
+
+// Lambda bodies are either a block or a single expression whose value is
+// returned, so they can sit inside argument lists without a semicolon ---
+func parseLambdaBody(p *parser) ast.Statement {
+	if p.currentTokenType() == lexer.LEFT_BRACE {
+		p.advance()
+		return parseBlockStatement(p)
+	}
+
+	expression := parseExpression(p, COMMA)
+	return &ast.ExpressionStatement{
+		Expression: expression,
+		Line:       p.line,
+	}
+}
+
+// start..end leaves out end, start..=end includes it ---
+func parseRangeLiteral(p *parser, left ast.Expression, bp BindingPower) ast.Expression {
+	inclusive := p.advance().TokenType == lexer.DOT_DOT_EQUALS
+	end := parseExpression(p, bp)
+
+	return &ast.RangeExpression{
+		Start:     left,
+		End:       end,
+		Inclusive: inclusive,
+		Line:      p.line,
+	}
+}
+
+func parseRangeExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// range(end)
+	// range(start, end)
+	// range(start, end, step)
+	//
+
+	p.advance() // Eat 'range' ---
+	p.expect(lexer.LEFT_PARENTHESIS)
+
+	var bounds []ast.Expression
+	bounds = append(bounds, parseExpression(p, COMMA))
+	for p.currentTokenType() == lexer.COMMA && len(bounds) < 3 {
+		p.advance() // Eat ',' ---
+		bounds = append(bounds, parseExpression(p, COMMA))
+	}
+
+	p.expect(lexer.RIGHT_PARENTHESIS)
+
+	expr := &ast.RangeExpression{Line: p.line}
+	switch len(bounds) {
+	case 1:
+		expr.End = bounds[0]
+	case 2:
+		expr.Start, expr.End = bounds[0], bounds[1]
+	default:
+		expr.Start, expr.End, expr.Step = bounds[0], bounds[1], bounds[2]
+	}
+
+	return expr
+}
+
+func parseThisExpression(p *parser) ast.Expression {
+	p.advance() // Eat 'this' ---
+	return &ast.ThisExpression{
+		Line: p.line,
+	}
+}
+
+func parseSuperExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// super.method
+	//
+
+	p.advance() // Eat 'super' ---
+	p.expect(lexer.DOT)
+	method := p.expect(lexer.IDENTIFIER).Lexeme
+
+	return &ast.SuperExpression{
+		Method: method,
+		Line:   p.line,
+	}
+}
+
+func parseTemplateExpression(p *parser) ast.Expression {
+	token := p.advance()
+	parts, _ := token.Literal.([]lexer.TemplatePart)
+
+	template := &ast.TemplateExpression{Line: token.Line}
+
+	for _, part := range parts {
+		if part.Tokens == nil {
+			template.Strings = append(template.Strings, part.Text)
+			continue
+		}
+
+		// Each interpolation is parsed on its own as a full expression ---
+		nested := instantiateParser(part.Tokens, p.errorHandler)
+		nested.line = token.Line
+
+		if nested.isEOF() {
+			p.errorHandler.ReportError(
+				"Parser-Template",
+				"Empty template expression '${}'",
+				token.Line,
+				errorhandler.UnexpectedTokenError,
+			)
+			return nil
+		}
+
+		expression := parseExpression(nested, DEFAULT_BP)
+		if expression == nil || p.errorHandler.HadError {
+			return nil
+		}
+
+		if !nested.isEOF() {
+			p.errorHandler.ReportError(
+				"Parser-Template",
+				fmt.Sprintf("Unexpected token '%s' in template expression", nested.currentToken().Lexeme),
+				nested.line,
+				errorhandler.UnexpectedTokenError,
+			)
+			return nil
+		}
+
+		template.Expressions = append(template.Expressions, expression)
+	}
+
+	return template
+}
+
+func parseMatchExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// match (subject) {
+	//     pattern => expression,
+	//     pattern if guard => { statements },
+	//     _ => expression,
+	// }
+	//
+
+	p.advance() // Eat 'match' ---
+	line := p.line
+
+	p.expect(lexer.LEFT_PARENTHESIS)
+	subject := parseExpression(p, DEFAULT_BP)
+	p.expect(lexer.RIGHT_PARENTHESIS)
+	p.expect(lexer.LEFT_BRACE)
+
+	var arms []ast.MatchArm
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACE {
+		pattern := parseMatchPattern(p)
+
+		var guard ast.Expression
+		if p.currentTokenType() == lexer.IF {
+			p.advance() // Eat 'if' ---
+			guard = parseExpression(p, COMMA)
+		}
+
+		p.expect(lexer.ARROW)
+		isBlock := p.currentTokenType() == lexer.LEFT_BRACE
+		body := parseLambdaBody(p)
+
+		if p.errorHandler.HadError {
+			return nil
+		}
+
+		arms = append(arms, ast.MatchArm{
+			Pattern: pattern,
+			Guard:   guard,
+			Body:    body,
+		})
+
+		// Arms are separated by commas, optional after a block ---
+		if p.currentTokenType() == lexer.COMMA {
+			p.advance()
+		} else if !isBlock && p.currentTokenType() != lexer.RIGHT_BRACE {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return &ast.MatchExpression{
+		Subject: subject,
+		Arms:    arms,
+		Line:    line,
+	}
+}
+
+func parseSpawnExpression(p *parser) ast.Expression {
+	// SYNTAX ---
+	// spawn worker(args)
+	// spawn fn () { ... }
+	//
+
+	p.advance() // Eat 'spawn' ---
+	line := p.line
+
+	return &ast.SpawnExpression{
+		Value: parseExpression(p, UNARY),
+		Line:  line,
+	}
+}
@@ -11,11 +11,19 @@ const (
 	DEFAULT_BP BindingPower = iota
 	COMMA
 	ASSIGNMENT
-	LOGICAL
+	TERNARY
+	LOGICAL_OR
+	LOGICAL_AND
+	RANGE
 	RELATIONAL
+	BITWISE_OR
+	BITWISE_XOR
+	BITWISE_AND
+	SHIFT
 	ADDITIVE
 	MULTIPLICATIVE
 	UNARY
+	EXPONENT
 	POSTFIX
 	CALL
 	MEMBER
@@ -42,7 +50,10 @@ func led(tokenType lexer.TokenType, bp BindingPower, ledFunction LedHandler) {
 }
 
 func nud(tokenType lexer.TokenType, nudFunction NudHandler) {
-	bindingPowerLU[tokenType] = PRIMARY
+	// Tokens that are also infix operators (e.g. '-') keep their led binding power ---
+	if _, exists := ledLU[tokenType]; !exists {
+		bindingPowerLU[tokenType] = PRIMARY
+	}
 	nudLU[tokenType] = nudFunction
 }
 
@@ -55,18 +66,37 @@ func createTokenLookups() {
 
 	// LITERALS AND SYMBOLS ---
 	nud(lexer.NUMBER, parsePrimaryExpression)
+	nud(lexer.BIGINT, parsePrimaryExpression)
+	nud(lexer.DECIMAL, parsePrimaryExpression)
 	nud(lexer.IDENTIFIER, parsePrimaryExpression)
 	nud(lexer.STRING, parsePrimaryExpression)
+	nud(lexer.TEMPLATE, parseTemplateExpression)
 	nud(lexer.LEFT_PARENTHESIS, parsePrimaryExpression)
 	nud(lexer.LEFT_BRACE, parseObjectExpression)
 	led(lexer.DOT, MEMBER, parseMemberExpression)
+	led(lexer.QUESTION_DOT, MEMBER, parseOptionalChainExpression)
 
 	// ARRAYS ---
 	nud(lexer.LEFT_BRACKET, parseArrayExpression)
+	nud(lexer.HASH_BRACE, parseMapExpression)
+	nud(lexer.HASH_BRACKET, parseSetExpression)
 	led(lexer.LEFT_BRACKET, CALL, parseIndexExpression)
 
+	// RANGES ---
+	nud(lexer.RANGE, parseRangeExpression)
+	led(lexer.DOT_DOT, RANGE, parseRangeLiteral)
+	led(lexer.DOT_DOT_EQUALS, RANGE, parseRangeLiteral)
+	nud(lexer.MATCH, parseMatchExpression)
+	nud(lexer.YIELD, parseYieldExpression)
+	nud(lexer.SPAWN, parseSpawnExpression)
+
 	// COMPOUND OPERATORS ---
 	led(lexer.PLUS_EQUALS, ASSIGNMENT, parseAssignmentExpression)
+	led(lexer.MINUS_EQUALS, ASSIGNMENT, parseAssignmentExpression)
+	led(lexer.STAR_EQUALS, ASSIGNMENT, parseAssignmentExpression)
+	led(lexer.SLASH_EQUALS, ASSIGNMENT, parseAssignmentExpression)
+	led(lexer.MODULO_EQUALS, ASSIGNMENT, parseAssignmentExpression)
+	led(lexer.NULLISH_COALESCING_EQUALS, ASSIGNMENT, parseAssignmentExpression)
 
 	// POSTFIX EXPRESSION ---
 	led(lexer.PLUS_PLUS, POSTFIX, parsePostfixExpression)
@@ -78,6 +108,16 @@ func createTokenLookups() {
 	led(lexer.SLASH, MULTIPLICATIVE, parseBinaryExpression)
 	led(lexer.MODULO, MULTIPLICATIVE, parseBinaryExpression)
 	led(lexer.STAR, MULTIPLICATIVE, parseBinaryExpression)
+	led(lexer.TILDE_SLASH, MULTIPLICATIVE, parseBinaryExpression)
+	led(lexer.STAR_STAR, EXPONENT, parseExponentExpression)
+
+	// BITWISE OPERATORS ---
+	led(lexer.PIPE, BITWISE_OR, parseBinaryExpression)
+	led(lexer.CARET, BITWISE_XOR, parseBinaryExpression)
+	led(lexer.AMPERSAND, BITWISE_AND, parseBinaryExpression)
+	led(lexer.SHIFT_LEFT, SHIFT, parseBinaryExpression)
+	led(lexer.SHIFT_RIGHT, SHIFT, parseBinaryExpression)
+	nud(lexer.TILDE, parseUnaryExpression)
 
 	// STATEMENTS ---
 	led(lexer.ASSIGNMENT, ASSIGNMENT, parseAssignmentExpression)
@@ -85,21 +125,39 @@ func createTokenLookups() {
 	statement(lexer.CONSTANT, parseVariableDeclaration)
 	statement(lexer.IF, parseIfStatement)
 	statement(lexer.FUNCTION, parseFunctionDeclaration)
+	nud(lexer.FUNCTION, parseFunctionExpression)
 	statement(lexer.WHILE, parseWhileStatement)
 	statement(lexer.FOR, parseForStatement)
+	statement(lexer.CLASS, parseClassDeclaration)
+	statement(lexer.ENUM, parseEnumDeclaration)
+	statement(lexer.TRY, parseTryStatement)
+	statement(lexer.IMPORT, parseImportStatement)
+	statement(lexer.EXPORT, parseExportStatement)
 
 	// KEYWORDS ---
+	nud(lexer.THIS, parseThisExpression)
+	nud(lexer.SUPER, parseSuperExpression)
 	statement(lexer.RETURN, parseReturnStatement)
 	statement(lexer.CONTINUE, parseContinueStatement)
 	statement(lexer.BREAK, parseBreakStatement)
+	statement(lexer.THROW, parseThrowStatement)
+	statement(lexer.MATCH, parseMatchStatement)
+	statement(lexer.SELECT, parseSelectStatement)
 
 	// CALL EXPRESSION ---
 	led(lexer.LEFT_PARENTHESIS, CALL, parseCallExpression)
 
 	// UNARY OPERATORS ---
 	nud(lexer.NOT, parseUnaryExpression)
+	nud(lexer.BANG, parseUnaryExpression)
 	nud(lexer.MINUS, parseUnaryExpression)
 
+	// LOGICAL OPERATORS ---
+	led(lexer.AND, LOGICAL_AND, parseBinaryExpression)
+	led(lexer.OR, LOGICAL_OR, parseBinaryExpression)
+	led(lexer.NULLISH_COALESCING, LOGICAL_OR, parseBinaryExpression)
+	led(lexer.QUESTION, TERNARY, parseTernaryExpression)
+
 	// COMPARISON OPERATORS --+
 	led(lexer.GREATER, RELATIONAL, parseBinaryExpression)
 	led(lexer.GREATER_EQUAL, RELATIONAL, parseBinaryExpression)
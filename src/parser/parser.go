@@ -65,6 +65,34 @@ func (p *parser) currentToken() *lexer.Token {
 	return p.tokens[p.position]
 }
 
+func (p *parser) peekTokenType(offset int) lexer.TokenType {
+	index := p.position + offset
+	if index >= len(p.tokens) {
+		return lexer.EOF
+	}
+	return p.tokens[index].TokenType
+}
+
+// Scans ahead from the current '(' to its matching ')' and reports
+// whether it is followed by '=>' (an arrow function parameter list) ---
+func (p *parser) isArrowFunction() bool {
+	depth := 0
+	for index := p.position; index < len(p.tokens); index++ {
+		switch p.tokens[index].TokenType {
+		case lexer.LEFT_PARENTHESIS:
+			depth++
+		case lexer.RIGHT_PARENTHESIS:
+			depth--
+			if depth == 0 {
+				return p.peekTokenType(index-p.position+1) == lexer.ARROW
+			}
+		case lexer.EOF:
+			return false
+		}
+	}
+	return false
+}
+
 func (p *parser) isEOF() bool {
 	return p.position >= len(p.tokens) || p.currentTokenType() == lexer.EOF
 }
@@ -0,0 +1,419 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+func parsePattern(p *parser) ast.Pattern {
+	// SYNTAX ---
+	// identifier
+	// [a, b = 1, ...rest]
+	// { key, key: target, key = default, ...rest }
+	//
+
+	switch p.currentTokenType() {
+	case lexer.LEFT_BRACKET:
+		return parseArrayPattern(p)
+	case lexer.LEFT_BRACE:
+		return parseObjectPattern(p)
+	default:
+		return &ast.IdentifierPattern{
+			Name: p.expect(lexer.IDENTIFIER).Lexeme,
+			Line: p.line,
+		}
+	}
+}
+
+// A pattern nested inside another one, which may carry a default value ---
+func parsePatternElement(p *parser) ast.Pattern {
+	target := parsePattern(p)
+	return parsePatternDefault(p, target)
+}
+
+func parsePatternDefault(p *parser, target ast.Pattern) ast.Pattern {
+	if p.currentTokenType() != lexer.ASSIGNMENT {
+		return target
+	}
+
+	p.advance() // Eat '=' ---
+
+	return &ast.DefaultPattern{
+		Target:  target,
+		Default: parseExpression(p, COMMA),
+		Line:    p.line,
+	}
+}
+
+func parseArrayPattern(p *parser) ast.Pattern {
+	var elements []ast.Pattern
+
+	p.advance() // Eat '[' ---
+
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACKET {
+		switch p.currentTokenType() {
+		case lexer.COMMA:
+			elements = append(elements, nil) // Skipped position ---
+		case lexer.ELLIPSIS:
+			elements = append(elements, parseRestPattern(p))
+			if p.currentTokenType() != lexer.RIGHT_BRACKET {
+				reportMisplacedRest(p)
+				return nil
+			}
+			continue
+		default:
+			elements = append(elements, parsePatternElement(p))
+		}
+
+		if p.currentTokenType() != lexer.RIGHT_BRACKET {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACKET)
+
+	return &ast.ArrayPattern{
+		Elements: elements,
+		Line:     p.line,
+	}
+}
+
+func parseObjectPattern(p *parser) ast.Pattern {
+	var properties []ast.ObjectPatternProperty
+	var rest ast.Pattern
+
+	p.advance() // Eat '{' ---
+
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACE {
+		if p.currentTokenType() == lexer.ELLIPSIS {
+			rest = parseRestPattern(p)
+			if p.currentTokenType() != lexer.RIGHT_BRACE {
+				reportMisplacedRest(p)
+				return nil
+			}
+			break
+		}
+
+		key := p.expect(lexer.IDENTIFIER).Lexeme
+
+		var value ast.Pattern
+		if p.currentTokenType() == lexer.COLON {
+			p.advance() // Eat ':' ---
+			value = parsePatternElement(p)
+		} else {
+			value = parsePatternDefault(p, &ast.IdentifierPattern{Name: key, Line: p.line})
+		}
+
+		properties = append(properties, ast.ObjectPatternProperty{
+			Key:   key,
+			Value: value,
+		})
+
+		if p.currentTokenType() != lexer.RIGHT_BRACE {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return &ast.ObjectPattern{
+		Properties: properties,
+		Rest:       rest,
+		Line:       p.line,
+	}
+}
+
+func parseRestPattern(p *parser) ast.Pattern {
+	p.advance() // Eat '...' ---
+
+	return &ast.RestPattern{
+		Target: &ast.IdentifierPattern{
+			Name: p.expect(lexer.IDENTIFIER).Lexeme,
+			Line: p.line,
+		},
+		Line: p.line,
+	}
+}
+
+func reportMisplacedRest(p *parser) {
+	p.errorHandler.ReportError(
+		"Parser-Pattern",
+		"A rest element must be the last element of a pattern",
+		p.line,
+		errorhandler.UnexpectedTokenError,
+	)
+}
+
+// Reinterprets an already parsed array/object literal as an assignment target ---
+func expressionToPattern(p *parser, expr ast.Expression) ast.Pattern {
+	switch e := expr.(type) {
+	case *ast.SymbolExpression:
+		return &ast.IdentifierPattern{Name: e.Value, Line: e.Line}
+	case *ast.MemberExpression, *ast.IndexExpression:
+		return &ast.ExpressionPattern{Target: e, Line: e.GetLine()}
+	case *ast.AssignmentExpression:
+		if e.Operator == lexer.ASSIGNMENT {
+			target := expressionToPattern(p, e.Assignee)
+			if target == nil {
+				return nil
+			}
+			return &ast.DefaultPattern{Target: target, Default: e.Value, Line: e.Line}
+		}
+	case *ast.SpreadExpression:
+		target := expressionToPattern(p, e.Value)
+		if target == nil {
+			return nil
+		}
+		return &ast.RestPattern{Target: target, Line: e.Line}
+	case *ast.ArrayExpression:
+		var elements []ast.Pattern
+		for idx, element := range e.Elements {
+			if _, isSpread := element.(*ast.SpreadExpression); isSpread && idx != len(e.Elements)-1 {
+				reportMisplacedRest(p)
+				return nil
+			}
+
+			target := expressionToPattern(p, element)
+			if target == nil {
+				return nil
+			}
+			elements = append(elements, target)
+		}
+		return &ast.ArrayPattern{Elements: elements, Line: e.Line}
+	case *ast.ObjectExpression:
+		var properties []ast.ObjectPatternProperty
+		var rest ast.Pattern
+		for idx, property := range e.Properties {
+			target := expressionToPattern(p, property.Value)
+			if target == nil {
+				return nil
+			}
+
+			if _, isRest := target.(*ast.RestPattern); isRest {
+				if idx != len(e.Properties)-1 {
+					reportMisplacedRest(p)
+					return nil
+				}
+				rest = target
+				continue
+			}
+
+			properties = append(properties, ast.ObjectPatternProperty{Key: property.Key, Value: target})
+		}
+		return &ast.ObjectPattern{Properties: properties, Rest: rest, Line: e.Line}
+	}
+
+	p.errorHandler.ReportError(
+		"Parser-Pattern",
+		fmt.Sprintf("Invalid destructuring assignment target (%T)", expr),
+		p.line,
+		errorhandler.UnexpectedTokenError,
+	)
+	return nil
+}
+
+// Match arm patterns are refutable: besides bindings they can test
+// literals, alternatives and the '_' wildcard ---
+func parseMatchPattern(p *parser) ast.Pattern {
+	// SYNTAX ---
+	// pattern | pattern | ...
+	//
+
+	line := p.line
+	pattern := parseMatchPrimaryPattern(p)
+	if p.currentTokenType() != lexer.PIPE {
+		return pattern
+	}
+
+	alternatives := []ast.Pattern{pattern}
+	for p.currentTokenType() == lexer.PIPE {
+		p.advance() // Eat '|' ---
+		alternatives = append(alternatives, parseMatchPrimaryPattern(p))
+	}
+
+	return &ast.AlternativePattern{
+		Alternatives: alternatives,
+		Line:         line,
+	}
+}
+
+func parseMatchPrimaryPattern(p *parser) ast.Pattern {
+	switch p.currentTokenType() {
+	case lexer.UNDERSCORE:
+		p.advance()
+		return &ast.WildcardPattern{Line: p.line}
+
+	case lexer.NUMBER, lexer.BIGINT, lexer.DECIMAL, lexer.STRING:
+		return &ast.LiteralPattern{
+			Value: parsePrimaryExpression(p),
+			Line:  p.line,
+		}
+
+	case lexer.MINUS:
+		operator := p.advance()
+		if next := p.currentTokenType(); next != lexer.NUMBER && next != lexer.BIGINT && next != lexer.DECIMAL {
+			break
+		}
+		return &ast.LiteralPattern{
+			Value: &ast.UnaryExpression{Operator: operator, Operand: parsePrimaryExpression(p), Line: p.line},
+			Line:  p.line,
+		}
+
+	case lexer.IDENTIFIER:
+		name := p.currentToken().Lexeme
+		if name == "true" || name == "false" || name == "nil" || p.peekTokenType(1) == lexer.DOT {
+			value := parseDottedName(p)
+			if p.currentTokenType() == lexer.LEFT_PARENTHESIS {
+				return parseVariantPattern(p, value)
+			}
+
+			return &ast.LiteralPattern{
+				Value: value,
+				Line:  p.line,
+			}
+		}
+
+		p.advance()
+		return &ast.IdentifierPattern{Name: name, Line: p.line}
+
+	case lexer.LEFT_PARENTHESIS:
+		p.advance() // Eat '(' ---
+		pattern := parseMatchPattern(p)
+		p.expect(lexer.RIGHT_PARENTHESIS)
+		return pattern
+
+	case lexer.LEFT_BRACKET:
+		return parseMatchArrayPattern(p)
+
+	case lexer.LEFT_BRACE:
+		return parseMatchObjectPattern(p)
+	}
+
+	p.errorHandler.ReportError(
+		"Parser-Pattern",
+		fmt.Sprintf("Unexpected token '%s' in match pattern", p.currentToken().Lexeme),
+		p.line,
+		errorhandler.UnexpectedTokenError,
+	)
+	p.advance()
+	return nil
+}
+
+// `name` or `name.member.member`, compared by value ---
+func parseDottedName(p *parser) ast.Expression {
+	var expression ast.Expression = &ast.SymbolExpression{
+		Value: p.advance().Lexeme,
+		Line:  p.line,
+	}
+
+	for p.currentTokenType() == lexer.DOT {
+		p.advance() // Eat '.' ---
+		expression = &ast.MemberExpression{
+			Object:   expression,
+			Property: p.expect(lexer.IDENTIFIER).Lexeme,
+			Line:     p.line,
+		}
+	}
+
+	return expression
+}
+
+func parseVariantPattern(p *parser, variant ast.Expression) ast.Pattern {
+	var fields []ast.Pattern
+
+	p.advance() // Eat '(' ---
+
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_PARENTHESIS {
+		fields = append(fields, parseMatchPattern(p))
+
+		if p.currentTokenType() != lexer.RIGHT_PARENTHESIS {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_PARENTHESIS)
+
+	return &ast.VariantPattern{
+		Variant: variant,
+		Fields:  fields,
+		Line:    p.line,
+	}
+}
+
+func parseMatchArrayPattern(p *parser) ast.Pattern {
+	var elements []ast.Pattern
+
+	p.advance() // Eat '[' ---
+
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACKET {
+		if p.currentTokenType() == lexer.ELLIPSIS {
+			elements = append(elements, parseRestPattern(p))
+			if p.currentTokenType() != lexer.RIGHT_BRACKET {
+				reportMisplacedRest(p)
+				return nil
+			}
+			break
+		}
+
+		elements = append(elements, parseMatchPattern(p))
+
+		if p.currentTokenType() != lexer.RIGHT_BRACKET {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACKET)
+
+	return &ast.ArrayPattern{
+		Elements: elements,
+		Line:     p.line,
+	}
+}
+
+func parseMatchObjectPattern(p *parser) ast.Pattern {
+	var properties []ast.ObjectPatternProperty
+	var rest ast.Pattern
+
+	p.advance() // Eat '{' ---
+
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACE {
+		if p.currentTokenType() == lexer.ELLIPSIS {
+			rest = parseRestPattern(p)
+			if p.currentTokenType() != lexer.RIGHT_BRACE {
+				reportMisplacedRest(p)
+				return nil
+			}
+			break
+		}
+
+		key := p.expect(lexer.IDENTIFIER).Lexeme
+
+		var value ast.Pattern
+		if p.currentTokenType() == lexer.COLON {
+			p.advance() // Eat ':' ---
+			value = parseMatchPattern(p)
+		} else {
+			value = &ast.IdentifierPattern{Name: key, Line: p.line}
+		}
+
+		properties = append(properties, ast.ObjectPatternProperty{
+			Key:   key,
+			Value: value,
+		})
+
+		if p.currentTokenType() != lexer.RIGHT_BRACE {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return &ast.ObjectPattern{
+		Properties: properties,
+		Rest:       rest,
+		Line:       p.line,
+	}
+}
@@ -20,6 +20,10 @@ func parseStatement(p *parser) ast.Statement {
 		return stmt
 	}
 
+	return parseExpressionStatement(p)
+}
+
+func parseExpressionStatement(p *parser) ast.Statement {
 	expression := parseExpression(p, DEFAULT_BP)
 
 	if expression == nil {
@@ -46,6 +50,25 @@ func parseStatement(p *parser) ast.Statement {
 	}
 }
 
+// A match used as a statement doesn't need a trailing semicolon ---
+func parseMatchStatement(p *parser) ast.Statement {
+	expression := parseExpression(p, DEFAULT_BP)
+
+	if expression == nil {
+		p.synchronize()
+		return nil // Error already reported
+	}
+
+	if p.currentTokenType() == lexer.SEMICOLON {
+		p.advance()
+	}
+
+	return &ast.ExpressionStatement{
+		Expression: expression,
+		Line:       p.line,
+	}
+}
+
 func parseVariableDeclaration(p *parser) ast.Statement {
 	//
 	//  var <identifier> = [value]; ---
@@ -54,6 +77,22 @@ func parseVariableDeclaration(p *parser) ast.Statement {
 	//
 
 	isConstant := p.advance().TokenType == lexer.CONSTANT
+
+	// var [a, b] = ...; / const { x, y } = ...; ---
+	if p.currentTokenType() == lexer.LEFT_BRACKET || p.currentTokenType() == lexer.LEFT_BRACE {
+		pattern := parsePattern(p)
+		p.expectError("Destructuring declarations must be initialized", lexer.ASSIGNMENT)
+		value := parseExpression(p, DEFAULT_BP)
+		p.expect(lexer.SEMICOLON)
+
+		return &ast.VariableDeclarationStatement{
+			IsConstant: isConstant,
+			Pattern:    pattern,
+			Value:      value,
+			Line:       p.line,
+		}
+	}
+
 	identifier := p.expect(lexer.IDENTIFIER).Lexeme
 	var value ast.Expression
 
@@ -144,45 +183,148 @@ func parseFunctionDeclaration(p *parser) ast.Statement {
 	// SYNTAX ---
 	// fn identifier(params) { ... }
 	// fn identifier(params) ...
+	// fn* identifier(params) { ... }
 	//
 
 	var identifier string
-	var parameters []string
+	var parameters []ast.Pattern
 	var body ast.Statement
 
+	// Anonymous function used as a statement, e.g. `fn (x) { ... }(1);` ---
+	if p.peekTokenType(1) == lexer.LEFT_PARENTHESIS ||
+		(p.peekTokenType(1) == lexer.STAR && p.peekTokenType(2) == lexer.LEFT_PARENTHESIS) {
+		return parseExpressionStatement(p)
+	}
+
 	p.advance()
+	isGenerator := parseGeneratorMarker(p)
 
 	identifier = p.expect(lexer.IDENTIFIER).Lexeme
+	parameters = parseParameters(p)
+	body = parseFunctionBody(p)
+
+	return &ast.FunctionDeclarationStatement{
+		Name:        identifier,
+		Parameters:  parameters,
+		Body:        body,
+		IsGenerator: isGenerator,
+		Line:        p.line,
+	}
+}
+
+func parseClassDeclaration(p *parser) ast.Statement {
+	// SYNTAX ---
+	// class Name { init(params) { ... } method(params) { ... } }
+	// class Name extends Base { ... }
+	//
+
+	var superclass ast.Expression
+	var methods []*ast.FunctionDeclarationStatement
+
+	p.advance()
+
+	identifier := p.expect(lexer.IDENTIFIER).Lexeme
+
+	if p.currentTokenType() == lexer.EXTENDS {
+		p.advance() // Eat 'extends' ---
+		superclass = parseSuperclass(p)
+	}
+
+	p.expect(lexer.LEFT_BRACE)
+
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACE {
+		if p.currentTokenType() == lexer.FUNCTION {
+			p.advance() // The 'fn' keyword is optional for methods ---
+		}
+		isGenerator := parseGeneratorMarker(p)
+
+		name := p.expect(lexer.IDENTIFIER).Lexeme
+		parameters := parseParameters(p)
+		body := parseFunctionBody(p)
+
+		if p.errorHandler.HadError {
+			return nil
+		}
+
+		methods = append(methods, &ast.FunctionDeclarationStatement{
+			Name:        name,
+			Parameters:  parameters,
+			Body:        body,
+			IsGenerator: isGenerator,
+			Line:        p.line,
+		})
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return &ast.ClassDeclarationStatement{
+		Name:       identifier,
+		Superclass: superclass,
+		Methods:    methods,
+		Line:       p.line,
+	}
+}
+
+// A primary expression followed by member, index and call links, e.g.
+// `extends shapes.Base`. The class body's '{' ends it instead of being
+// read as an object literal; the interpreter checks it is a class ---
+func parseSuperclass(p *parser) ast.Expression {
+	superclass := parseExpression(p, PRIMARY)
+
+	for superclass != nil {
+		switch tokenType := p.currentTokenType(); tokenType {
+		case lexer.DOT, lexer.QUESTION_DOT, lexer.LEFT_BRACKET, lexer.LEFT_PARENTHESIS:
+			superclass = ledLU[tokenType](p, superclass, bindingPowerLU[tokenType])
+		default:
+			return superclass
+		}
+	}
+
+	return nil
+}
+
+func parseParameters(p *parser) []ast.Pattern {
+	var parameters []ast.Pattern
 
 	p.expect(lexer.LEFT_PARENTHESIS)
 
 	if p.currentTokenType() != lexer.RIGHT_PARENTHESIS {
-		param := p.expect(lexer.IDENTIFIER).Lexeme
-		parameters = append(parameters, param)
+		parameters = append(parameters, parseParameter(p))
 
 		// Parse remaining parameters (comma-separated)
 		for p.currentTokenType() == lexer.COMMA {
 			p.advance() // eat comma
-			param := p.expect(lexer.IDENTIFIER).Lexeme
-			parameters = append(parameters, param)
+			parameters = append(parameters, parseParameter(p))
+		}
+	}
+
+	// A variadic `...rest` parameter collects the remaining arguments ---
+	for idx, param := range parameters {
+		if _, isRest := param.(*ast.RestPattern); isRest && idx != len(parameters)-1 {
+			reportMisplacedRest(p)
 		}
 	}
 
 	p.expect(lexer.RIGHT_PARENTHESIS)
 
+	return parameters
+}
+
+func parseParameter(p *parser) ast.Pattern {
+	if p.currentTokenType() == lexer.ELLIPSIS {
+		return parseRestPattern(p)
+	}
+
+	return parsePatternElement(p) // Parameters may declare a default value ---
+}
+
+func parseFunctionBody(p *parser) ast.Statement {
 	if p.currentTokenType() == lexer.LEFT_BRACE {
 		p.advance()
-		body = parseBlockStatement(p)
-	} else {
-		body = parseStatement(p)
+		return parseBlockStatement(p)
 	}
 
-	return &ast.FunctionDeclarationStatement{
-		Name:       identifier,
-		Parameters: parameters,
-		Body:       body,
-		Line:       p.line,
-	}
+	return parseStatement(p)
 }
 
 func parseWhileStatement(p *parser) ast.Statement {
@@ -238,6 +380,10 @@ func parseForStatement(p *parser) ast.Statement {
 
 	p.expect(lexer.LEFT_PARENTHESIS)
 
+	if isForOfHead(p) {
+		return parseForOfStatement(p)
+	}
+
 	if p.currentTokenType() != lexer.VARIABLE {
 		p.errorHandler.ReportError(
 			"Parser-For",
@@ -256,12 +402,7 @@ func parseForStatement(p *parser) ast.Statement {
 	increment = parseExpression(p, DEFAULT_BP)
 	p.expect(lexer.RIGHT_PARENTHESIS)
 
-	if p.currentTokenType() == lexer.LEFT_BRACE {
-		p.advance()
-		body = parseBlockStatement(p)
-	} else {
-		body = parseStatement(p)
-	}
+	body = parseLoopBody(p)
 
 	return &ast.ForStatement{
 		Init:      init,
@@ -272,6 +413,62 @@ func parseForStatement(p *parser) ast.Statement {
 	}
 }
 
+// Checks for `var x of` / `var k, v of` right after the opening '(' ---
+func isForOfHead(p *parser) bool {
+	tokenType := p.currentTokenType()
+	if tokenType != lexer.VARIABLE && tokenType != lexer.CONSTANT {
+		return false
+	}
+
+	if p.peekTokenType(1) != lexer.IDENTIFIER {
+		return false
+	}
+
+	next := p.peekTokenType(2)
+	return next == lexer.OF || next == lexer.COMMA
+}
+
+func parseForOfStatement(p *parser) ast.Statement {
+	// SYNTAX ---
+	//
+	// for (var x of iterable) { ... }
+	// for (const k, v of iterable) { ... }
+	//
+
+	var identifiers []string
+
+	isConstant := p.advance().TokenType == lexer.CONSTANT
+
+	identifiers = append(identifiers, p.expect(lexer.IDENTIFIER).Lexeme)
+	if p.currentTokenType() == lexer.COMMA {
+		p.advance() // Eat ',' ---
+		identifiers = append(identifiers, p.expect(lexer.IDENTIFIER).Lexeme)
+	}
+
+	p.expect(lexer.OF)
+	iterable := parseExpression(p, DEFAULT_BP)
+	p.expect(lexer.RIGHT_PARENTHESIS)
+
+	body := parseLoopBody(p)
+
+	return &ast.ForOfStatement{
+		IsConstant:  isConstant,
+		Identifiers: identifiers,
+		Iterable:    iterable,
+		Body:        body,
+		Line:        p.line,
+	}
+}
+
+func parseLoopBody(p *parser) ast.Statement {
+	if p.currentTokenType() == lexer.LEFT_BRACE {
+		p.advance()
+		return parseBlockStatement(p)
+	}
+
+	return parseStatement(p)
+}
+
 func parseReturnStatement(p *parser) ast.Statement {
 	var value ast.Expression
 
@@ -308,3 +505,311 @@ func parseContinueStatement(p *parser) ast.Statement {
 		Line: line,
 	}
 }
+
+func parseTryStatement(p *parser) ast.Statement {
+	// SYNTAX ---
+	//
+	// try { ... } catch (e) { ... }
+	// try { ... } catch { ... } finally { ... }
+	// try { ... } finally { ... }
+	//
+
+	var catchParam string
+	var catch ast.Statement
+	var finally ast.Statement
+
+	line := p.line
+
+	p.advance()
+	p.expect(lexer.LEFT_BRACE)
+	body := parseBlockStatement(p)
+
+	if p.currentTokenType() == lexer.CATCH {
+		p.advance() // Eat 'catch' ---
+
+		if p.currentTokenType() == lexer.LEFT_PARENTHESIS {
+			p.advance() // Eat '(' ---
+			catchParam = p.expect(lexer.IDENTIFIER).Lexeme
+			p.expect(lexer.RIGHT_PARENTHESIS)
+		}
+
+		p.expect(lexer.LEFT_BRACE)
+		catch = parseBlockStatement(p)
+	}
+
+	if p.currentTokenType() == lexer.FINALLY {
+		p.advance() // Eat 'finally' ---
+		p.expect(lexer.LEFT_BRACE)
+		finally = parseBlockStatement(p)
+	}
+
+	if catch == nil && finally == nil {
+		p.errorHandler.ReportError(
+			"Parser-Try",
+			"Expected a catch or finally block after try",
+			p.line,
+			errorhandler.UnexpectedTokenError,
+		)
+		return nil
+	}
+
+	return &ast.TryStatement{
+		Body:       body,
+		CatchParam: catchParam,
+		Catch:      catch,
+		Finally:    finally,
+		Line:       line,
+	}
+}
+
+func parseThrowStatement(p *parser) ast.Statement {
+	p.expect(lexer.THROW)
+	value := parseExpression(p, COMMA)
+	p.expect(lexer.SEMICOLON)
+
+	return &ast.ThrowStatement{
+		Value: value,
+		Line:  p.line,
+	}
+}
+
+func parseImportStatement(p *parser) ast.Statement {
+	// SYNTAX ---
+	//
+	// import { a, b } from "./util.len";
+	// import "./util.len" as util;
+	// import "./setup.len";
+	//
+
+	var names []string
+	var alias string
+	var path string
+
+	p.advance()
+
+	if p.currentTokenType() == lexer.LEFT_BRACE {
+		p.advance() // Eat '{' ---
+
+		names = append(names, p.expect(lexer.IDENTIFIER).Lexeme)
+		for p.currentTokenType() == lexer.COMMA {
+			p.advance() // Eat ',' ---
+			names = append(names, p.expect(lexer.IDENTIFIER).Lexeme)
+		}
+
+		p.expect(lexer.RIGHT_BRACE)
+		p.expect(lexer.FROM)
+		path = parseModulePath(p)
+	} else {
+		path = parseModulePath(p)
+
+		if p.currentTokenType() == lexer.AS {
+			p.advance() // Eat 'as' ---
+			alias = p.expect(lexer.IDENTIFIER).Lexeme
+		}
+	}
+
+	p.expect(lexer.SEMICOLON)
+
+	return &ast.ImportStatement{
+		Path:  path,
+		Names: names,
+		Alias: alias,
+		Line:  p.line,
+	}
+}
+
+func parseModulePath(p *parser) string {
+	token := p.expectError("Expected a module path string", lexer.STRING)
+	if path, ok := token.Literal.(string); ok {
+		return path
+	}
+	return ""
+}
+
+func parseEnumDeclaration(p *parser) ast.Statement {
+	// SYNTAX ---
+	// enum Name { A, B, C }
+	// enum Name { Ok(value), Err(message) }
+	//
+
+	line := p.line
+
+	p.advance() // Eat 'enum' ---
+	identifier := p.expect(lexer.IDENTIFIER).Lexeme
+	p.expect(lexer.LEFT_BRACE)
+
+	var variants []ast.EnumVariant
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACE {
+		variant := ast.EnumVariant{Name: p.expect(lexer.IDENTIFIER).Lexeme}
+
+		for _, existing := range variants {
+			if existing.Name == variant.Name {
+				p.errorHandler.ReportError(
+					"Parser-Enum",
+					fmt.Sprintf("Duplicate variant '%s' in enum '%s'", variant.Name, identifier),
+					p.line,
+					errorhandler.UnexpectedTokenError,
+				)
+				return nil
+			}
+		}
+
+		if p.currentTokenType() == lexer.LEFT_PARENTHESIS {
+			p.advance() // Eat '(' ---
+			for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_PARENTHESIS {
+				variant.Fields = append(variant.Fields, p.expect(lexer.IDENTIFIER).Lexeme)
+				if p.currentTokenType() != lexer.RIGHT_PARENTHESIS {
+					p.expect(lexer.COMMA)
+				}
+			}
+			p.expect(lexer.RIGHT_PARENTHESIS)
+		}
+
+		if p.errorHandler.HadError {
+			return nil
+		}
+
+		variants = append(variants, variant)
+
+		if p.currentTokenType() != lexer.RIGHT_BRACE {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return &ast.EnumDeclarationStatement{
+		Name:     identifier,
+		Variants: variants,
+		Line:     line,
+	}
+}
+
+func parseSelectStatement(p *parser) ast.Statement {
+	// SYNTAX ---
+	// select {
+	//     channel.recv() as value => { ... }
+	//     channel.send(value) => expression,
+	//     _ => { ... }
+	// }
+	//
+
+	line := p.line
+
+	p.advance() // Eat 'select' ---
+	p.expect(lexer.LEFT_BRACE)
+
+	stmt := &ast.SelectStatement{Line: line}
+	for !p.isEOF() && p.currentTokenType() != lexer.RIGHT_BRACE {
+		isDefault := p.currentTokenType() == lexer.UNDERSCORE
+
+		var selectCase ast.SelectCase
+		if isDefault {
+			p.advance() // Eat '_' ---
+			if stmt.Default != nil {
+				p.errorHandler.ReportError(
+					"Parser-Select",
+					"A select statement can only have one '_' arm",
+					p.line,
+					errorhandler.UnexpectedTokenError,
+				)
+				return nil
+			}
+		} else {
+			selectCase = parseSelectCase(p)
+		}
+
+		p.expect(lexer.ARROW)
+		isBlock := p.currentTokenType() == lexer.LEFT_BRACE
+		body := parseLambdaBody(p)
+
+		if p.errorHandler.HadError {
+			return nil
+		}
+
+		if isDefault {
+			stmt.Default = body
+		} else {
+			selectCase.Body = body
+			stmt.Cases = append(stmt.Cases, selectCase)
+		}
+
+		if p.currentTokenType() == lexer.COMMA {
+			p.advance()
+		} else if !isBlock && p.currentTokenType() != lexer.RIGHT_BRACE {
+			p.expect(lexer.COMMA)
+		}
+	}
+
+	p.expect(lexer.RIGHT_BRACE)
+
+	return stmt
+}
+
+func parseSelectCase(p *parser) ast.SelectCase {
+	expression := parseExpression(p, DEFAULT_BP)
+
+	call, isCall := expression.(*ast.CallExpression)
+	var member *ast.MemberExpression
+	if isCall {
+		member, _ = call.Caller.(*ast.MemberExpression)
+	}
+
+	switch {
+	case member != nil && member.Property == "recv" && len(call.Arguments) == 0:
+		selectCase := ast.SelectCase{Channel: member.Object}
+		if p.currentTokenType() == lexer.AS {
+			p.advance() // Eat 'as' ---
+			selectCase.Binding = p.expect(lexer.IDENTIFIER).Lexeme
+		}
+		return selectCase
+
+	case member != nil && member.Property == "send" && len(call.Arguments) == 1:
+		return ast.SelectCase{
+			Channel: member.Object,
+			IsSend:  true,
+			Value:   call.Arguments[0],
+		}
+	}
+
+	if !p.errorHandler.HadError {
+		p.errorHandler.ReportError(
+			"Parser-Select",
+			"Expected 'channel.recv()', 'channel.send(value)' or '_' in select arm",
+			p.line,
+			errorhandler.UnexpectedTokenError,
+		)
+	}
+	return ast.SelectCase{}
+}
+
+func parseExportStatement(p *parser) ast.Statement {
+	// SYNTAX ---
+	//
+	// export var x = 1;
+	// export fn name(params) { ... }
+	// export class Name { ... }
+	// export enum Name { ... }
+	//
+
+	line := p.line
+
+	p.advance()
+
+	switch p.currentTokenType() {
+	case lexer.VARIABLE, lexer.CONSTANT, lexer.FUNCTION, lexer.CLASS, lexer.ENUM:
+	default:
+		p.errorHandler.ReportError(
+			"Parser-Export",
+			fmt.Sprintf("Expected a declaration after export, got %s instead", lexer.TokenTypeString[p.currentTokenType()]),
+			p.line,
+			errorhandler.UnexpectedTokenError,
+		)
+		return nil
+	}
+
+	return &ast.ExportStatement{
+		Declaration: parseStatement(p),
+		Line:        line,
+	}
+}
@@ -0,0 +1,755 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+// Go-implemented method of a built-in type, given the value it was called on ---
+type BuiltinMethod func(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue
+
+// Read like a field instead of being called, e.g. arr.length ---
+type BuiltinProperty func(receiver RuntimeValue, i *Interpreter) RuntimeValue
+
+// Members every value of one built-in type has ---
+type BuiltinTable struct {
+	Methods    map[string]BuiltinMethod
+	Properties map[string]BuiltinProperty
+}
+
+// Filled in by init(): methods like arr.map() call back into the
+// interpreter, which looks members up in this table ---
+var BUILTIN_TABLES map[ValueTypes]*BuiltinTable
+
+func init() {
+	numberTable := &BuiltinTable{
+		Methods: map[string]BuiltinMethod{
+			"floor": builtinNumberFloor,
+			"ceil":  builtinNumberCeil,
+			"round": builtinNumberRound,
+			"abs":   builtinNumberAbs,
+		},
+	}
+
+	BUILTIN_TABLES = map[ValueTypes]*BuiltinTable{
+		STRING_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"toUpper":    builtinStringToUpper,
+				"toLower":    builtinStringToLower,
+				"trim":       builtinStringTrim,
+				"split":      builtinStringSplit,
+				"contains":   builtinStringContains,
+				"startsWith": builtinStringStartsWith,
+				"endsWith":   builtinStringEndsWith,
+				"indexOf":    builtinStringIndexOf,
+				"replace":    builtinStringReplace,
+				"repeat":     builtinStringRepeat,
+			},
+			Properties: map[string]BuiltinProperty{
+				"length": builtinLength,
+			},
+		},
+		ARRAY_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"push":     builtinArrayPush,
+				"pop":      builtinArrayPop,
+				"contains": builtinArrayContains,
+				"indexOf":  builtinArrayIndexOf,
+				"join":     builtinArrayJoin,
+				"reverse":  builtinArrayReverse,
+				"map":      builtinArrayMap,
+				"filter":   builtinArrayFilter,
+				"reduce":   builtinArrayReduce,
+				"forEach":  builtinArrayForEach,
+			},
+			Properties: map[string]BuiltinProperty{
+				"length": builtinLength,
+			},
+		},
+		OBJECT_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"keys":    builtinObjectKeys,
+				"values":  builtinObjectValues,
+				"entries": builtinObjectEntries,
+				"has":     builtinObjectHas,
+				"hasOwn":  builtinObjectHasOwn,
+				"remove":  builtinObjectRemove,
+			},
+		},
+		MAP_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"get":     builtinMapGet,
+				"set":     builtinMapSet,
+				"has":     builtinMapHas,
+				"delete":  builtinMapDelete,
+				"clear":   builtinMapClear,
+				"keys":    builtinMapKeys,
+				"values":  builtinMapValues,
+				"entries": builtinMapEntries,
+				"forEach": builtinMapForEach,
+			},
+			Properties: map[string]BuiltinProperty{
+				"length": builtinCollectionLength,
+			},
+		},
+		SET_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"add":          builtinSetAdd,
+				"has":          builtinSetHas,
+				"delete":       builtinSetDelete,
+				"clear":        builtinSetClear,
+				"values":       builtinSetValues,
+				"union":        builtinSetUnion,
+				"intersection": builtinSetIntersection,
+				"difference":   builtinSetDifference,
+			},
+			Properties: map[string]BuiltinProperty{
+				"length": builtinCollectionLength,
+			},
+		},
+		NUMBER_VALUE:  numberTable,
+		INTEGER_VALUE: numberTable,
+		BIGINT_VALUE:  numberTable,
+		DECIMAL_VALUE: numberTable,
+		GENERATOR_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"next":  builtinGeneratorNext,
+				"close": builtinGeneratorClose,
+			},
+			Properties: map[string]BuiltinProperty{
+				"done": func(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+					return BOOLEAN(receiver.(*GeneratorValue).Done)
+				},
+			},
+		},
+		TASK_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"wait": func(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+					return i.waitTask(receiver.(*TaskValue))
+				},
+			},
+			Properties: map[string]BuiltinProperty{
+				"done": func(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+					return BOOLEAN(receiver.(*TaskValue).IsDone())
+				},
+			},
+		},
+		CHANNEL_VALUE: {
+			Methods: map[string]BuiltinMethod{
+				"send":  builtinChannelSend,
+				"recv":  builtinChannelRecv,
+				"close": builtinChannelClose,
+			},
+			Properties: map[string]BuiltinProperty{
+				"closed": func(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+					return BOOLEAN(receiver.(*ChannelValue).closed.Load())
+				},
+			},
+		},
+		ERROR_VALUE: {
+			Properties: map[string]BuiltinProperty{
+				"message": func(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+					return &StringValue{Value: receiver.(*ErrorValue).Message}
+				},
+				"code": func(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+					return &StringValue{Value: receiver.(*ErrorValue).Code}
+				},
+				"line": func(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+					return &IntegerValue{Value: int64(receiver.(*ErrorValue).Line)}
+				},
+			},
+		},
+	}
+}
+
+// Looks a member up in the receiver's built-in table. Methods come back
+// bound to the receiver so they can be stored and called later ---
+func (i *Interpreter) getBuiltinMember(receiver RuntimeValue, name string) (RuntimeValue, bool) {
+	table, exists := BUILTIN_TABLES[receiver.Type()]
+	if !exists {
+		return nil, false
+	}
+
+	if property, exists := table.Properties[name]; exists {
+		return property(receiver, i), true
+	}
+
+	if method, exists := table.Methods[name]; exists {
+		return NATIVE_FUNCTION(name, func(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+			return method(receiver, args, i)
+		}), true
+	}
+
+	return nil, false
+}
+
+// Reports an error unless min <= len(args) <= max ---
+func (i *Interpreter) expectArguments(name string, args []RuntimeValue, min int, max int) bool {
+	if len(args) >= min && len(args) <= max {
+		return true
+	}
+
+	expected := fmt.Sprintf("%d", min)
+	if max != min {
+		expected = fmt.Sprintf("%d to %d", min, max)
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Builtin",
+		fmt.Sprintf("%s() expects %s argument(s), got %d", name, expected, len(args)),
+		i.line,
+		errorhandler.ArgumentLengthError,
+	)
+	return false
+}
+
+func (i *Interpreter) expectString(name string, value RuntimeValue) (string, bool) {
+	if str, ok := value.(*StringValue); ok {
+		return str.Value, true
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Builtin",
+		fmt.Sprintf("%s() expects a string, got '%s'", name, value.Type()),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+	return "", false
+}
+
+// Calls a callback with as many of the arguments as it declares, so
+// arr.map(fn(x) ...) and arr.map(fn(x, idx) ...) both work ---
+func (i *Interpreter) callCallback(callback RuntimeValue, args ...RuntimeValue) RuntimeValue {
+	if function, ok := callback.(*FunctionValue); ok {
+		if _, maximum := functionArity(function); maximum >= 0 && maximum < len(args) {
+			args = args[:maximum]
+		}
+	}
+	return i.callValue(callback, args, i.globalEnv)
+}
+
+func builtinLength(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+	switch v := receiver.(type) {
+	case *StringValue:
+		return &IntegerValue{Value: int64(utf8.RuneCountInString(v.Value))}
+	case *ArrayValue:
+		return &IntegerValue{Value: int64(v.length())}
+	}
+	return NIL()
+}
+
+// STRINGS ---
+
+func builtinStringToUpper(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("toUpper", args, 0, 0) {
+		return NIL()
+	}
+	return &StringValue{Value: strings.ToUpper(receiver.(*StringValue).Value)}
+}
+
+func builtinStringToLower(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("toLower", args, 0, 0) {
+		return NIL()
+	}
+	return &StringValue{Value: strings.ToLower(receiver.(*StringValue).Value)}
+}
+
+func builtinStringTrim(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("trim", args, 0, 0) {
+		return NIL()
+	}
+	return &StringValue{Value: strings.TrimSpace(receiver.(*StringValue).Value)}
+}
+
+// split() with no separator splits on whitespace ---
+func builtinStringSplit(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("split", args, 0, 1) {
+		return NIL()
+	}
+
+	var parts []string
+	if len(args) == 0 {
+		parts = strings.Fields(receiver.(*StringValue).Value)
+	} else {
+		separator, ok := i.expectString("split", args[0])
+		if !ok {
+			return NIL()
+		}
+		parts = strings.Split(receiver.(*StringValue).Value, separator)
+	}
+
+	elements := make([]RuntimeValue, len(parts))
+	for idx, part := range parts {
+		elements[idx] = &StringValue{Value: part}
+	}
+	return ARRAY(elements)
+}
+
+func builtinStringContains(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("contains", args, 1, 1) {
+		return NIL()
+	}
+	part, ok := i.expectString("contains", args[0])
+	if !ok {
+		return NIL()
+	}
+	return BOOLEAN(strings.Contains(receiver.(*StringValue).Value, part))
+}
+
+func builtinStringStartsWith(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("startsWith", args, 1, 1) {
+		return NIL()
+	}
+	prefix, ok := i.expectString("startsWith", args[0])
+	if !ok {
+		return NIL()
+	}
+	return BOOLEAN(strings.HasPrefix(receiver.(*StringValue).Value, prefix))
+}
+
+func builtinStringEndsWith(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("endsWith", args, 1, 1) {
+		return NIL()
+	}
+	suffix, ok := i.expectString("endsWith", args[0])
+	if !ok {
+		return NIL()
+	}
+	return BOOLEAN(strings.HasSuffix(receiver.(*StringValue).Value, suffix))
+}
+
+// Counts characters like slicing does, -1 when missing ---
+func builtinStringIndexOf(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("indexOf", args, 1, 1) {
+		return NIL()
+	}
+	part, ok := i.expectString("indexOf", args[0])
+	if !ok {
+		return NIL()
+	}
+
+	str := receiver.(*StringValue).Value
+	index := strings.Index(str, part)
+	if index < 0 {
+		return &IntegerValue{Value: -1}
+	}
+	return &IntegerValue{Value: int64(utf8.RuneCountInString(str[:index]))}
+}
+
+func builtinStringReplace(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("replace", args, 2, 2) {
+		return NIL()
+	}
+	old, ok := i.expectString("replace", args[0])
+	if !ok {
+		return NIL()
+	}
+	replacement, ok := i.expectString("replace", args[1])
+	if !ok {
+		return NIL()
+	}
+	return &StringValue{Value: strings.ReplaceAll(receiver.(*StringValue).Value, old, replacement)}
+}
+
+func builtinStringRepeat(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("repeat", args, 1, 1) {
+		return NIL()
+	}
+
+	count, ok := toInteger(args[0])
+	if !ok || count < 0 {
+		i.errorHandler.ReportError("Interpreter-Builtin", "repeat() expects a non-negative integer", i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+	return &StringValue{Value: strings.Repeat(receiver.(*StringValue).Value, int(count))}
+}
+
+// ARRAYS ---
+
+// Appends in place and returns the new length ---
+func builtinArrayPush(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	length := 0
+	receiver.(*ArrayValue).mutate(func(elements []RuntimeValue) []RuntimeValue {
+		elements = append(elements, args...)
+		length = len(elements)
+		return elements
+	})
+	return &IntegerValue{Value: int64(length)}
+}
+
+// Removes and returns the last element, nil when empty ---
+func builtinArrayPop(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("pop", args, 0, 0) {
+		return NIL()
+	}
+
+	var last RuntimeValue = NIL()
+	receiver.(*ArrayValue).mutate(func(elements []RuntimeValue) []RuntimeValue {
+		if len(elements) == 0 {
+			return elements
+		}
+		last = elements[len(elements)-1]
+		return elements[:len(elements)-1]
+	})
+	return last
+}
+
+func builtinArrayContains(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("contains", args, 1, 1) {
+		return NIL()
+	}
+	return BOOLEAN(indexOfValue(receiver.(*ArrayValue), args[0]) >= 0)
+}
+
+func builtinArrayIndexOf(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("indexOf", args, 1, 1) {
+		return NIL()
+	}
+	return &IntegerValue{Value: int64(indexOfValue(receiver.(*ArrayValue), args[0]))}
+}
+
+func indexOfValue(array *ArrayValue, target RuntimeValue) int {
+	for idx, element := range array.snapshot() {
+		if valuesEqual(element, target) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Elements are shown the way print shows them ---
+func builtinArrayJoin(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("join", args, 0, 1) {
+		return NIL()
+	}
+
+	separator := ","
+	if len(args) == 1 {
+		var ok bool
+		if separator, ok = i.expectString("join", args[0]); !ok {
+			return NIL()
+		}
+	}
+
+	parts := make([]string, 0)
+	for _, element := range receiver.(*ArrayValue).snapshot() {
+		parts = append(parts, i.display(element))
+	}
+	return &StringValue{Value: strings.Join(parts, separator)}
+}
+
+// Returns a reversed copy ---
+func builtinArrayReverse(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("reverse", args, 0, 0) {
+		return NIL()
+	}
+
+	elements := receiver.(*ArrayValue).snapshot()
+	reversed := make([]RuntimeValue, len(elements))
+	for idx, element := range elements {
+		reversed[len(elements)-1-idx] = element
+	}
+	return ARRAY(reversed)
+}
+
+// Callbacks receive (element, index) ---
+func builtinArrayMap(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("map", args, 1, 1) {
+		return NIL()
+	}
+
+	mapped := make([]RuntimeValue, 0)
+	for idx, element := range receiver.(*ArrayValue).snapshot() {
+		result := i.callCallback(args[0], element, &IntegerValue{Value: int64(idx)})
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		mapped = append(mapped, result)
+	}
+	return ARRAY(mapped)
+}
+
+func builtinArrayFilter(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("filter", args, 1, 1) {
+		return NIL()
+	}
+
+	kept := make([]RuntimeValue, 0)
+	for idx, element := range receiver.(*ArrayValue).snapshot() {
+		result := i.callCallback(args[0], element, &IntegerValue{Value: int64(idx)})
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		if isTruthy(result) {
+			kept = append(kept, element)
+		}
+	}
+	return ARRAY(kept)
+}
+
+// reduce(fn(total, element, index), initial); without an initial value
+// the first element starts the total ---
+func builtinArrayReduce(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("reduce", args, 1, 2) {
+		return NIL()
+	}
+
+	elements := receiver.(*ArrayValue).snapshot()
+	start := 0
+
+	var total RuntimeValue
+	if len(args) == 2 {
+		total = args[1]
+	} else {
+		if len(elements) == 0 {
+			i.errorHandler.ReportError("Interpreter-Builtin", "reduce() of an empty array needs an initial value", i.line, errorhandler.InvalidArgumentError)
+			return NIL()
+		}
+		total = elements[0]
+		start = 1
+	}
+
+	for idx := start; idx < len(elements); idx++ {
+		total = i.callCallback(args[0], total, elements[idx], &IntegerValue{Value: int64(idx)})
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+	}
+	return total
+}
+
+func builtinArrayForEach(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("forEach", args, 1, 1) {
+		return NIL()
+	}
+
+	for idx, element := range receiver.(*ArrayValue).snapshot() {
+		i.callCallback(args[0], element, &IntegerValue{Value: int64(idx)})
+		if i.errorHandler.HadError {
+			break
+		}
+	}
+	return NIL()
+}
+
+// OBJECTS ---
+
+func builtinObjectKeys(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("keys", args, 0, 0) {
+		return NIL()
+	}
+
+	keys := make([]RuntimeValue, 0)
+	for _, property := range receiver.(*ObjectValue).properties() {
+		keys = append(keys, &StringValue{Value: property.Key})
+	}
+	return ARRAY(keys)
+}
+
+func builtinObjectValues(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("values", args, 0, 0) {
+		return NIL()
+	}
+
+	values := make([]RuntimeValue, 0)
+	for _, property := range receiver.(*ObjectValue).properties() {
+		values = append(values, property.Value)
+	}
+	return ARRAY(values)
+}
+
+// [[key, value], ...] ---
+func builtinObjectEntries(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("entries", args, 0, 0) {
+		return NIL()
+	}
+
+	entries := make([]RuntimeValue, 0)
+	for _, property := range receiver.(*ObjectValue).properties() {
+		entries = append(entries, ARRAY([]RuntimeValue{&StringValue{Value: property.Key}, property.Value}))
+	}
+	return ARRAY(entries)
+}
+
+// Own or inherited ---
+func builtinObjectHas(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("has", args, 1, 1) {
+		return NIL()
+	}
+	key, ok := i.expectString("has", args[0])
+	if !ok {
+		return NIL()
+	}
+
+	_, exists := receiver.(*ObjectValue).Lookup(key)
+	return BOOLEAN(exists)
+}
+
+// Like has(), ignoring inherited properties ---
+func builtinObjectHasOwn(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("hasOwn", args, 1, 1) {
+		return NIL()
+	}
+	key, ok := i.expectString("hasOwn", args[0])
+	if !ok {
+		return NIL()
+	}
+
+	_, exists := receiver.(*ObjectValue).Get(key)
+	return BOOLEAN(exists)
+}
+
+// Deletes a key in place and returns its value (nil when missing) ---
+func builtinObjectRemove(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("remove", args, 1, 1) {
+		return NIL()
+	}
+	key, ok := i.expectString("remove", args[0])
+	if !ok {
+		return NIL()
+	}
+
+	if value, existed := receiver.(*ObjectValue).Delete(key); existed {
+		return value
+	}
+	return NIL()
+}
+
+// NUMBERS ---
+
+// Floats round to integers; integers and bigints are already whole,
+// decimals stay decimals ---
+func roundNumber(receiver RuntimeValue, floatRound func(float64) float64, decimalMode string) RuntimeValue {
+	switch v := receiver.(type) {
+	case *NumberValue:
+		rounded := floatRound(v.Value)
+		if math.IsNaN(rounded) || math.IsInf(rounded, 0) || rounded < math.MinInt64 || rounded >= math.MaxInt64 {
+			return &NumberValue{Value: rounded}
+		}
+		return &IntegerValue{Value: int64(rounded)}
+	case *DecimalValue:
+		return roundDecimal(v, 0, decimalMode)
+	}
+	return receiver
+}
+
+func builtinNumberFloor(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("floor", args, 0, 0) {
+		return NIL()
+	}
+	return roundNumber(receiver, math.Floor, ROUND_FLOOR)
+}
+
+func builtinNumberCeil(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("ceil", args, 0, 0) {
+		return NIL()
+	}
+	return roundNumber(receiver, math.Ceil, ROUND_CEILING)
+}
+
+// round() rounds halves away from zero; round(places) keeps that many
+// fractional digits. Decimals use the rounding mode of decimalContext() ---
+func builtinNumberRound(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("round", args, 0, 1) {
+		return NIL()
+	}
+
+	_, rounding := i.decimals.settings()
+	if len(args) == 0 {
+		return roundNumber(receiver, math.Round, rounding)
+	}
+
+	places, ok := toInteger(args[0])
+	if !ok || places < 0 {
+		i.errorHandler.ReportError("Interpreter-Builtin", "round() places must be a non-negative integer", i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+
+	switch v := receiver.(type) {
+	case *NumberValue:
+		scale := math.Pow(10, float64(places))
+		return &NumberValue{Value: math.Round(v.Value*scale) / scale}
+	case *DecimalValue:
+		return roundDecimal(v, int(places), rounding)
+	}
+	return receiver
+}
+
+func builtinNumberAbs(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("abs", args, 0, 0) {
+		return NIL()
+	}
+
+	switch v := receiver.(type) {
+	case *NumberValue:
+		return &NumberValue{Value: math.Abs(v.Value)}
+	case *IntegerValue:
+		if v.Value < 0 {
+			return &IntegerValue{Value: -v.Value}
+		}
+	case *BigIntValue:
+		return &BigIntValue{Value: new(big.Int).Abs(v.Value)}
+	case *DecimalValue:
+		return &DecimalValue{Unscaled: new(big.Int).Abs(v.Unscaled), Scale: v.Scale}
+	}
+	return receiver
+}
+
+// GENERATORS, CHANNELS ---
+
+// next(value) resumes the generator, sending value back as the result
+// of the paused `yield` ---
+func builtinGeneratorNext(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("next", args, 0, 1) {
+		return NIL()
+	}
+
+	var sent RuntimeValue = NIL()
+	if len(args) == 1 {
+		sent = args[0]
+	}
+
+	value, done := i.resumeGenerator(receiver.(*GeneratorValue), sent)
+	return OBJECT([]ObjectPropertyValue{
+		{Key: "value", Value: value},
+		{Key: "done", Value: BOOLEAN(done)},
+	})
+}
+
+// close() ends the generator early, running its pending finally blocks ---
+func builtinGeneratorClose(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("close", args, 0, 0) {
+		return NIL()
+	}
+	i.closeGenerator(receiver.(*GeneratorValue))
+	return NIL()
+}
+
+func builtinChannelSend(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("send", args, 1, 1) {
+		return NIL()
+	}
+	i.sendToChannel(receiver.(*ChannelValue), args[0])
+	return NIL()
+}
+
+func builtinChannelRecv(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("recv", args, 0, 0) {
+		return NIL()
+	}
+	value, _ := i.receiveFromChannel(receiver.(*ChannelValue))
+	return value
+}
+
+func builtinChannelClose(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("close", args, 0, 0) {
+		return NIL()
+	}
+	i.closeChannel(receiver.(*ChannelValue))
+	return NIL()
+}
@@ -0,0 +1,139 @@
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+const (
+	CLASS_INITIALIZER = "init"
+	THIS_BINDING      = "this"
+	SUPER_BINDING     = "super"
+)
+
+func (i *Interpreter) evaluateClassDeclaration(stmt *ast.ClassDeclarationStatement, env Environment) RuntimeValue {
+	var superclass *ClassValue
+	methodEnv := env
+
+	if stmt.Superclass != nil {
+		value := i.EvaluateExpression(stmt.Superclass, env)
+		class, ok := value.(*ClassValue)
+		if !ok {
+			i.errorHandler.ReportError(
+				"Interpreter-Class",
+				fmt.Sprintf("Class '%s' can only extend another class, got '%s'", stmt.Name, value.Type()),
+				i.line,
+				errorhandler.ClassError,
+			)
+			return NIL()
+		}
+		superclass = class
+
+		// Methods of a subclass close over a scope that knows its parent class ---
+		methodEnv = NewEnvironment(env, i.errorHandler)
+		methodEnv.DeclareVariable(stmt.Line, SUPER_BINDING, superclass, true, true)
+	}
+
+	class := &ClassValue{
+		Name:       stmt.Name,
+		Superclass: superclass,
+		Methods:    make(map[string]*FunctionValue),
+		File:       i.errorHandler.File,
+	}
+
+	for _, method := range stmt.Methods {
+		class.Methods[method.Name] = &FunctionValue{
+			Name:        method.Name,
+			Parameters:  method.Parameters,
+			Body:        method.Body,
+			IsGenerator: method.IsGenerator,
+			Environment: methodEnv,
+			File:        class.File,
+		}
+	}
+
+	env.DeclareVariable(stmt.Line, stmt.Name, class, true, false)
+	return class
+}
+
+func (i *Interpreter) instantiateClass(class *ClassValue, args []RuntimeValue) RuntimeValue {
+	instance := &InstanceValue{
+		Class:  class,
+		Fields: OBJECT(nil),
+	}
+
+	initializer, exists := class.FindMethod(CLASS_INITIALIZER)
+	if !exists {
+		if len(args) != 0 {
+			i.errorHandler.ReportError(
+				"Interpreter-Class",
+				fmt.Sprintf("Class '%s' expects 0 argument(s) but got %d instead", class.Name, len(args)),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+		}
+		return instance
+	}
+
+	i.callFunction(i.bindMethod(initializer, instance), args)
+	return instance
+}
+
+// Returns a copy of the method whose scope has 'this' bound to the receiver ---
+func (i *Interpreter) bindMethod(method *FunctionValue, receiver RuntimeValue) *FunctionValue {
+	methodScope := NewEnvironment(method.Environment, i.errorHandler)
+	methodScope.DeclareVariable(i.line, THIS_BINDING, receiver, true, true)
+
+	return &FunctionValue{
+		Name:        method.Name,
+		Parameters:  method.Parameters,
+		Body:        method.Body,
+		IsGenerator: method.IsGenerator,
+		Environment: methodScope,
+		File:        method.File,
+	}
+}
+
+func (i *Interpreter) evaluateThisExpression(expr *ast.ThisExpression, env Environment) RuntimeValue {
+	if env.ResolveVariable(expr.Line, THIS_BINDING) == nil {
+		i.errorHandler.ReportError(
+			"Interpreter-Class",
+			"Cannot use 'this' outside of a method",
+			i.line,
+			errorhandler.ClassError,
+		)
+		return NIL()
+	}
+
+	return env.LookupVariable(expr.Line, THIS_BINDING)
+}
+
+func (i *Interpreter) evaluateSuperExpression(expr *ast.SuperExpression, env Environment) RuntimeValue {
+	if env.ResolveVariable(expr.Line, SUPER_BINDING) == nil {
+		i.errorHandler.ReportError(
+			"Interpreter-Class",
+			"Cannot use 'super' outside of a subclass method",
+			i.line,
+			errorhandler.ClassError,
+		)
+		return NIL()
+	}
+
+	superclass := env.LookupVariable(expr.Line, SUPER_BINDING).(*ClassValue)
+	receiver := env.LookupVariable(expr.Line, THIS_BINDING)
+
+	method, exists := superclass.FindMethod(expr.Method)
+	if !exists {
+		i.errorHandler.ReportError(
+			"Interpreter-Class",
+			fmt.Sprintf("Superclass '%s' has no method '%s'", superclass.Name, expr.Method),
+			i.line,
+			errorhandler.ClassError,
+		)
+		return NIL()
+	}
+
+	return i.bindMethod(method, receiver)
+}
@@ -0,0 +1,523 @@
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Two keys are the same key when their hashKey() matches. Numbers,
+// booleans, strings, nil, arrays and enum variants hash by value (1, 1.0
+// and 1n are one key, like with ==); everything else by identity ---
+func hashKey(value RuntimeValue) string {
+	var builder strings.Builder
+	writeHashKey(&builder, value, nil)
+	return builder.String()
+}
+
+// open holds the arrays and variants enclosing value. Meeting one of them
+// again writes its depth instead, so self-containing keys still hash ---
+func writeHashKey(builder *strings.Builder, value RuntimeValue, open []RuntimeValue) {
+	if depth := openDepth(open, value); depth >= 0 {
+		fmt.Fprintf(builder, "^%d", depth)
+		return
+	}
+
+	switch v := value.(type) {
+	case *NilValue:
+		builder.WriteString("nil")
+	case *BooleanValue:
+		builder.WriteString("b:" + strconv.FormatBool(v.Value))
+	case *IntegerValue, *NumberValue, *BigIntValue, *DecimalValue:
+		if exact := toRational(value); exact != nil {
+			builder.WriteString("n:" + exact.RatString())
+		} else {
+			builder.WriteString("f:" + value.String()) // NaN and infinities ---
+		}
+	case *StringValue:
+		builder.WriteString("s:" + strconv.Quote(v.Value))
+	case *ArrayValue:
+		builder.WriteString("a[")
+		open = append(open, v)
+		for idx, element := range v.snapshot() {
+			if idx > 0 {
+				builder.WriteString(",")
+			}
+			writeHashKey(builder, element, open)
+		}
+		builder.WriteString("]")
+	case *EnumVariantValue:
+		fmt.Fprintf(builder, "v:%p:%d:%t(", v.Enum, v.Ordinal, v.IsConstructor())
+		open = append(open, v)
+		for idx, field := range v.Values {
+			if idx > 0 {
+				builder.WriteString(",")
+			}
+			writeHashKey(builder, field, open)
+		}
+		builder.WriteString(")")
+	default:
+		fmt.Fprintf(builder, "%s@%p", value.Type(), value)
+	}
+}
+
+// Array keys are copied on insertion, so changing the array afterwards
+// doesn't strand the entry under its old hash. Cycles are copied as
+// cycles, so the copy hashes like the original ---
+func freezeKey(value RuntimeValue) RuntimeValue {
+	return freezeArrays(value, make(map[*ArrayValue]*ArrayValue))
+}
+
+func freezeArrays(value RuntimeValue, copies map[*ArrayValue]*ArrayValue) RuntimeValue {
+	array, ok := value.(*ArrayValue)
+	if !ok {
+		return value
+	}
+
+	if frozen, exists := copies[array]; exists {
+		return frozen
+	}
+
+	elements := array.snapshot()
+	frozen := ARRAY(make([]RuntimeValue, len(elements)))
+	copies[array] = frozen
+	for idx, element := range elements {
+		frozen.Elements[idx] = freezeArrays(element, copies)
+	}
+	return frozen
+}
+
+type hashEntry struct {
+	Key   RuntimeValue
+	Value RuntimeValue
+}
+
+// Entries in insertion order, indexed by hashKey(). Tasks may share a
+// map, so every access takes the lock ---
+type hashTable struct {
+	mutex   sync.RWMutex
+	entries []hashEntry
+	index   map[string]int
+}
+
+func newHashTable() *hashTable {
+	return &hashTable{index: make(map[string]int)}
+}
+
+func (t *hashTable) get(key RuntimeValue) (RuntimeValue, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if position, exists := t.index[hashKey(key)]; exists {
+		return t.entries[position].Value, true
+	}
+	return nil, false
+}
+
+// Overwrites an existing entry only while it still holds expected ---
+func (t *hashTable) swap(key RuntimeValue, expected RuntimeValue, value RuntimeValue) bool {
+	hash := hashKey(key)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	position, exists := t.index[hash]
+	if !exists || t.entries[position].Value != expected {
+		return false
+	}
+	t.entries[position].Value = value
+	return true
+}
+
+// Overwriting keeps the entry's original position ---
+func (t *hashTable) set(key RuntimeValue, value RuntimeValue) {
+	hash := hashKey(key)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if position, exists := t.index[hash]; exists {
+		t.entries[position].Value = value
+		return
+	}
+
+	t.index[hash] = len(t.entries)
+	t.entries = append(t.entries, hashEntry{Key: freezeKey(key), Value: value})
+}
+
+func (t *hashTable) remove(key RuntimeValue) bool {
+	hash := hashKey(key)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	position, exists := t.index[hash]
+	if !exists {
+		return false
+	}
+
+	t.entries = append(t.entries[:position], t.entries[position+1:]...)
+	delete(t.index, hash)
+	for idx := position; idx < len(t.entries); idx++ {
+		t.index[hashKey(t.entries[idx].Key)] = idx
+	}
+	return true
+}
+
+func (t *hashTable) clear() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.entries = nil
+	t.index = make(map[string]int)
+}
+
+func (t *hashTable) len() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return len(t.entries)
+}
+
+// Copy of the entries, so loops can run user code that changes the table ---
+func (t *hashTable) snapshot() []hashEntry {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return append([]hashEntry(nil), t.entries...)
+}
+
+func (i *Interpreter) evaluateMapExpression(expr *ast.MapExpression, env Environment) RuntimeValue {
+	result := MAP()
+	for _, entry := range expr.Entries {
+		key := i.EvaluateExpression(entry.Key, env)
+		value := i.EvaluateExpression(entry.Value, env)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		result.Entries.set(key, value)
+	}
+	return result
+}
+
+func (i *Interpreter) evaluateSetExpression(expr *ast.SetExpression, env Environment) RuntimeValue {
+	result := SET()
+	for _, member := range i.evaluateElements(expr.Elements, env) {
+		result.Members.set(member, member)
+	}
+	return result
+}
+
+// m[key] = value, compound operators need the key to exist ---
+func (i *Interpreter) assignToMapKey(mapValue *MapValue, key RuntimeValue, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
+	if operator == lexer.ASSIGNMENT {
+		mapValue.Entries.set(key, value)
+		return value
+	}
+
+	// Recomputed if another task changed the entry meanwhile ---
+	for {
+		current, exists := mapValue.Entries.get(key)
+		if !exists {
+			i.errorHandler.Report(i.line,
+				fmt.Sprintf("Cannot use compound assignment on missing map key '%s'", i.display(key)))
+			return NIL()
+		}
+
+		finalValue := i.applyCompoundOperator(current, value, operator)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+
+		if mapValue.Entries.swap(key, current, finalValue) {
+			return finalValue
+		}
+	}
+}
+
+// Map() is empty; Map(source) copies a map, an object's properties or
+// an iterable of [key, value] pairs ---
+func NATIVE_MAP_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) > 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "Map() expects at most one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	result := MAP()
+	if len(args) == 0 {
+		return result
+	}
+
+	switch source := args[0].(type) {
+	case *MapValue:
+		for _, entry := range source.Entries.snapshot() {
+			result.Entries.set(entry.Key, entry.Value)
+		}
+		return result
+	case *ObjectValue:
+		for _, property := range source.properties() {
+			result.Entries.set(&StringValue{Value: property.Key}, property.Value)
+		}
+		return result
+	}
+
+	for _, pair := range i.collectValues(args[0]) {
+		entry, ok := pair.(*ArrayValue)
+		var elements []RuntimeValue
+		if ok {
+			elements = entry.snapshot()
+		}
+		if len(elements) != 2 {
+			i.errorHandler.ReportError(
+				"Interpreter-Native-Function",
+				fmt.Sprintf("Map() expects [key, value] pairs, got %s", i.display(pair)),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return NIL()
+		}
+		result.Entries.set(elements[0], elements[1])
+	}
+	return result
+}
+
+// Set() is empty; Set(iterable) holds each distinct value it yields ---
+func NATIVE_SET_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) > 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "Set() expects at most one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	result := SET()
+	if len(args) == 1 {
+		for _, member := range i.collectValues(args[0]) {
+			result.Members.set(member, member)
+		}
+	}
+	return result
+}
+
+func builtinCollectionLength(receiver RuntimeValue, i *Interpreter) RuntimeValue {
+	switch v := receiver.(type) {
+	case *MapValue:
+		return &IntegerValue{Value: int64(v.Entries.len())}
+	case *SetValue:
+		return &IntegerValue{Value: int64(v.Members.len())}
+	}
+	return NIL()
+}
+
+// MAPS ---
+
+// get(key, fallback?) returns fallback (or nil) for a missing key ---
+func builtinMapGet(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("get", args, 1, 2) {
+		return NIL()
+	}
+
+	if value, exists := receiver.(*MapValue).Entries.get(args[0]); exists {
+		return value
+	}
+	if len(args) == 2 {
+		return args[1]
+	}
+	return NIL()
+}
+
+// Returns the map so calls can be chained ---
+func builtinMapSet(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("set", args, 2, 2) {
+		return NIL()
+	}
+	receiver.(*MapValue).Entries.set(args[0], args[1])
+	return receiver
+}
+
+func builtinMapHas(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("has", args, 1, 1) {
+		return NIL()
+	}
+	_, exists := receiver.(*MapValue).Entries.get(args[0])
+	return BOOLEAN(exists)
+}
+
+// Reports whether the key was there ---
+func builtinMapDelete(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("delete", args, 1, 1) {
+		return NIL()
+	}
+	return BOOLEAN(receiver.(*MapValue).Entries.remove(args[0]))
+}
+
+func builtinMapClear(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("clear", args, 0, 0) {
+		return NIL()
+	}
+	receiver.(*MapValue).Entries.clear()
+	return NIL()
+}
+
+func builtinMapKeys(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("keys", args, 0, 0) {
+		return NIL()
+	}
+
+	keys := make([]RuntimeValue, 0)
+	for _, entry := range receiver.(*MapValue).Entries.snapshot() {
+		keys = append(keys, entry.Key)
+	}
+	return ARRAY(keys)
+}
+
+func builtinMapValues(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("values", args, 0, 0) {
+		return NIL()
+	}
+
+	values := make([]RuntimeValue, 0)
+	for _, entry := range receiver.(*MapValue).Entries.snapshot() {
+		values = append(values, entry.Value)
+	}
+	return ARRAY(values)
+}
+
+// [[key, value], ...] ---
+func builtinMapEntries(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("entries", args, 0, 0) {
+		return NIL()
+	}
+
+	entries := make([]RuntimeValue, 0)
+	for _, entry := range receiver.(*MapValue).Entries.snapshot() {
+		entries = append(entries, ARRAY([]RuntimeValue{entry.Key, entry.Value}))
+	}
+	return ARRAY(entries)
+}
+
+// Callbacks receive (value, key) ---
+func builtinMapForEach(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("forEach", args, 1, 1) {
+		return NIL()
+	}
+
+	for _, entry := range receiver.(*MapValue).Entries.snapshot() {
+		i.callCallback(args[0], entry.Value, entry.Key)
+		if i.errorHandler.HadError {
+			break
+		}
+	}
+	return NIL()
+}
+
+// SETS ---
+
+// Returns the set so calls can be chained ---
+func builtinSetAdd(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("add", args, 1, 1) {
+		return NIL()
+	}
+	receiver.(*SetValue).Members.set(args[0], args[0])
+	return receiver
+}
+
+func builtinSetHas(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("has", args, 1, 1) {
+		return NIL()
+	}
+	_, exists := receiver.(*SetValue).Members.get(args[0])
+	return BOOLEAN(exists)
+}
+
+func builtinSetDelete(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("delete", args, 1, 1) {
+		return NIL()
+	}
+	return BOOLEAN(receiver.(*SetValue).Members.remove(args[0]))
+}
+
+func builtinSetClear(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("clear", args, 0, 0) {
+		return NIL()
+	}
+	receiver.(*SetValue).Members.clear()
+	return NIL()
+}
+
+func builtinSetValues(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	if !i.expectArguments("values", args, 0, 0) {
+		return NIL()
+	}
+
+	values := make([]RuntimeValue, 0)
+	for _, entry := range receiver.(*SetValue).Members.snapshot() {
+		values = append(values, entry.Key)
+	}
+	return ARRAY(values)
+}
+
+// The other operand of union() and friends may be any iterable ---
+func (i *Interpreter) setOperand(name string, args []RuntimeValue) (*SetValue, bool) {
+	if !i.expectArguments(name, args, 1, 1) {
+		return nil, false
+	}
+	if set, ok := args[0].(*SetValue); ok {
+		return set, true
+	}
+
+	other := NATIVE_SET_FUNCTION(args, nil, i)
+	if i.errorHandler.HadError {
+		return nil, false
+	}
+	return other.(*SetValue), true
+}
+
+// New set with the members of both, this set's first ---
+func builtinSetUnion(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	other, ok := i.setOperand("union", args)
+	if !ok {
+		return NIL()
+	}
+
+	result := SET()
+	for _, set := range []*SetValue{receiver.(*SetValue), other} {
+		for _, entry := range set.Members.snapshot() {
+			result.Members.set(entry.Key, entry.Key)
+		}
+	}
+	return result
+}
+
+// New set with the members of this set that are also in the other ---
+func builtinSetIntersection(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	other, ok := i.setOperand("intersection", args)
+	if !ok {
+		return NIL()
+	}
+
+	result := SET()
+	for _, entry := range receiver.(*SetValue).Members.snapshot() {
+		if _, exists := other.Members.get(entry.Key); exists {
+			result.Members.set(entry.Key, entry.Key)
+		}
+	}
+	return result
+}
+
+// New set with the members of this set that aren't in the other ---
+func builtinSetDifference(receiver RuntimeValue, args []RuntimeValue, i *Interpreter) RuntimeValue {
+	other, ok := i.setOperand("difference", args)
+	if !ok {
+		return NIL()
+	}
+
+	result := SET()
+	for _, entry := range receiver.(*SetValue).Members.snapshot() {
+		if _, exists := other.Members.get(entry.Key); !exists {
+			result.Members.set(entry.Key, entry.Key)
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package runtime
+
+import "testing"
+
+func TestCyclicKeys(t *testing.T) {
+	runScriptTests(t, []scriptTest{
+		{
+			name:   "self-containing array as a map key",
+			source: `var s = []; s.push(s); var m = Map(); m[s] = 1; var got = m[s];`,
+			want:   map[string]string{"got": "1"},
+		},
+		{
+			name: "equal cyclic arrays are the same key",
+			source: `
+				var s = []; s.push(s);
+				var t = []; t.push(t);
+				var m = Map(); m[s] = 1;
+				var got = m[t];
+				var set = Set(); set.add(s); set.add(t);
+				var size = len(set);
+			`,
+			want: map[string]string{"got": "1", "size": "1"},
+		},
+		{
+			name:   "self-containing object as a map key",
+			source: `var o = { a: 1 }; o.self = o; var m = Map(); m[o] = 2; var got = m[o];`,
+			want:   map[string]string{"got": "2"},
+		},
+	})
+}
+
+func TestCyclicPrinting(t *testing.T) {
+	runScriptTests(t, []scriptTest{
+		{
+			name:   "array inside itself",
+			source: `var s = []; s.push(s);`,
+			want:   map[string]string{"s": "[[...]]"},
+		},
+		{
+			name:   "map keyed by a self-containing array",
+			source: `var s = []; s.push(s); var m = Map(); m[s] = 1; m[2] = m;`,
+			want:   map[string]string{"m": "#{[[...]]: 1, 2: #{...}}"},
+		},
+		{
+			name:   "set inside itself",
+			source: `var s = Set(); s.add(s);`,
+			want:   map[string]string{"s": "#[#[...]]"},
+		},
+		{
+			name:   "object inside itself",
+			source: `var o = { a: 1 }; o.self = o;`,
+			want:   map[string]string{"o": "{\n  a: 1,\n  self: {...},\n}"},
+		},
+		{
+			name:   "shared but acyclic values print in full",
+			source: `var shared = [1]; var both = [shared, shared];`,
+			want:   map[string]string{"both": "[[1], [1]]"},
+		},
+	})
+}
@@ -0,0 +1,226 @@
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+func (i *Interpreter) evaluateSpawnExpression(expr *ast.SpawnExpression, env Environment) RuntimeValue {
+	var callee RuntimeValue
+	var args []RuntimeValue
+
+	// Arguments are evaluated by the spawning task, the call runs on the new one ---
+	if call, ok := expr.Value.(*ast.CallExpression); ok {
+		callee, args = i.prepareCall(call, env)
+	} else {
+		callee = i.EvaluateExpression(expr.Value, env)
+	}
+
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	switch callee.(type) {
+	case *FunctionValue, *NativeFunctionValue, *ClassValue, *EnumVariantValue:
+	default:
+		i.errorHandler.ReportError(
+			"Interpreter-Spawn",
+			fmt.Sprintf("Cannot spawn non-function expression type '%s'", callee.Type()),
+			i.line,
+			errorhandler.NonFunctionExpressionError,
+		)
+		return NIL()
+	}
+
+	return i.startTask(callee, args, env)
+}
+
+// Each task runs on its own interpreter state and error handler.
+// Its errors stay in the task until someone waits on it ---
+func (i *Interpreter) startTask(callee RuntimeValue, args []RuntimeValue, env Environment) *TaskValue {
+	task := &TaskValue{
+		Name:     taskName(callee),
+		finished: make(chan struct{}),
+	}
+
+	handler := errorhandler.New()
+	handler.File = i.errorHandler.File
+	handler.BeginCatch()
+
+	forked := *i
+	forked.errorHandler = handler
+	forked.generator = nil
+	forked.thrown = nil
+	forked.isInFunction = false
+	forked.isInLoop = false
+	forked.holdsImportLock = false
+
+	go func() {
+		defer close(task.finished)
+
+		result := forked.callValue(callee, args, env)
+		if handler.HadError {
+			task.err = handler.LastError
+			task.thrown = forked.thrown
+			return
+		}
+		task.result = result
+	}()
+
+	return task
+}
+
+func taskName(callee RuntimeValue) string {
+	switch c := callee.(type) {
+	case *FunctionValue:
+		return c.Name
+	case *NativeFunctionValue:
+		return c.Name
+	case *ClassValue:
+		return c.Name
+	}
+	return ANONYMOUS_FUNCTION_NAME
+}
+
+// Blocks until the task is finished, then hands back its result or
+// raises its error in the waiting task ---
+func (i *Interpreter) waitTask(task *TaskValue) RuntimeValue {
+	<-task.finished
+
+	if task.err != nil {
+		i.thrown = task.thrown
+		i.errorHandler.Raise(task.err)
+		return NIL()
+	}
+
+	return task.result
+}
+
+func (i *Interpreter) sendToChannel(channel *ChannelValue, value RuntimeValue) {
+	defer func() {
+		if recover() != nil { // Closed while we were blocked on it ---
+			i.reportClosedChannel()
+		}
+	}()
+
+	if channel.closed.Load() {
+		i.reportClosedChannel()
+		return
+	}
+
+	channel.Channel <- value
+}
+
+// Receiving from a closed, drained channel gives nil ---
+func (i *Interpreter) receiveFromChannel(channel *ChannelValue) (RuntimeValue, bool) {
+	value, ok := <-channel.Channel
+	if !ok {
+		return NIL(), false
+	}
+	return value, true
+}
+
+func (i *Interpreter) closeChannel(channel *ChannelValue) {
+	if !channel.closed.CompareAndSwap(false, true) {
+		i.errorHandler.ReportError(
+			"Interpreter-Channel",
+			"Cannot close a channel that is already closed",
+			i.line,
+			errorhandler.ChannelError,
+		)
+		return
+	}
+
+	close(channel.Channel)
+}
+
+func (i *Interpreter) reportClosedChannel() {
+	i.errorHandler.ReportError(
+		"Interpreter-Channel",
+		"Cannot send on a closed channel",
+		i.line,
+		errorhandler.ChannelError,
+	)
+}
+
+func (i *Interpreter) evaluateSelectStatement(stmt *ast.SelectStatement, env Environment) RuntimeValue {
+	cases := make([]reflect.SelectCase, 0, len(stmt.Cases)+1)
+
+	for _, selectCase := range stmt.Cases {
+		channel, ok := i.EvaluateExpression(selectCase.Channel, env).(*ChannelValue)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		if !ok {
+			i.errorHandler.ReportError(
+				"Interpreter-Select",
+				"Select arms can only send to or receive from channels",
+				i.line,
+				errorhandler.ChannelError,
+			)
+			return NIL()
+		}
+
+		if !selectCase.IsSend {
+			cases = append(cases, reflect.SelectCase{
+				Dir:  reflect.SelectRecv,
+				Chan: reflect.ValueOf(channel.Channel),
+			})
+			continue
+		}
+
+		value := i.EvaluateExpression(selectCase.Value, env)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		if channel.closed.Load() {
+			i.reportClosedChannel()
+			return NIL()
+		}
+
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectSend,
+			Chan: reflect.ValueOf(channel.Channel),
+			Send: reflect.ValueOf(&value).Elem(),
+		})
+	}
+
+	if stmt.Default != nil {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	chosen, received, ok := i.selectChannels(cases)
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	if chosen == len(stmt.Cases) {
+		return i.EvaluateStatement(stmt.Default, NewEnvironment(env, i.errorHandler))
+	}
+
+	selectCase := stmt.Cases[chosen]
+	caseEnv := NewEnvironment(env, i.errorHandler)
+
+	if selectCase.Binding != "" {
+		var value RuntimeValue = NIL()
+		if ok {
+			value = received.Interface().(RuntimeValue)
+		}
+		caseEnv.DeclareVariable(i.line, selectCase.Binding, value, false, false)
+	}
+
+	return i.EvaluateStatement(selectCase.Body, caseEnv)
+}
+
+func (i *Interpreter) selectChannels(cases []reflect.SelectCase) (chosen int, received reflect.Value, ok bool) {
+	defer func() {
+		if recover() != nil { // A send arm's channel was closed meanwhile ---
+			i.reportClosedChannel()
+		}
+	}()
+
+	return reflect.Select(cases)
+}
@@ -0,0 +1,280 @@
+package runtime
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// How an inexact decimal result is rounded to its last kept digit ---
+const (
+	ROUND_HALF_EVEN = "half_even" // Banker's rounding, the default ---
+	ROUND_HALF_UP   = "half_up"
+	ROUND_HALF_DOWN = "half_down"
+	ROUND_UP        = "up"   // Away from zero ---
+	ROUND_DOWN      = "down" // Towards zero ---
+	ROUND_CEILING   = "ceiling"
+	ROUND_FLOOR     = "floor"
+)
+
+var ROUNDING_MODES = []string{
+	ROUND_HALF_EVEN, ROUND_HALF_UP, ROUND_HALF_DOWN, ROUND_UP, ROUND_DOWN, ROUND_CEILING, ROUND_FLOOR,
+}
+
+const DEFAULT_DECIMAL_PRECISION = 20
+
+// Settings decimalContext() changes. Precision is the number of
+// fractional digits a division keeps ---
+type decimalContext struct {
+	mutex     sync.RWMutex
+	precision int
+	rounding  string
+}
+
+func newDecimalContext() *decimalContext {
+	return &decimalContext{
+		precision: DEFAULT_DECIMAL_PRECISION,
+		rounding:  ROUND_HALF_EVEN,
+	}
+}
+
+func (c *decimalContext) settings() (int, string) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.precision, c.rounding
+}
+
+func (c *decimalContext) update(precision int, rounding string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.precision = precision
+	c.rounding = rounding
+}
+
+func isRoundingMode(mode string) bool {
+	for _, known := range ROUNDING_MODES {
+		if mode == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Parses "-12.340" style text; the lexer has already checked d"..." literals ---
+func parseDecimal(text string) (*DecimalValue, bool) {
+	text = strings.ReplaceAll(strings.TrimSpace(text), "_", "")
+	whole, fraction, _ := strings.Cut(text, ".")
+
+	unscaled, ok := new(big.Int).SetString(whole+fraction, 10)
+	if !ok || whole == "" || whole == "-" || whole == "+" || strings.ContainsAny(fraction, "+-") {
+		return nil, false
+	}
+	return &DecimalValue{Unscaled: unscaled, Scale: len(fraction)}, true
+}
+
+// Integers of any size convert exactly; floats must be converted with decimal() ---
+func toDecimal(value RuntimeValue) (*DecimalValue, bool) {
+	switch v := value.(type) {
+	case *DecimalValue:
+		return v, true
+	case *BigIntValue:
+		return &DecimalValue{Unscaled: v.Value, Scale: 0}, true
+	case *IntegerValue:
+		return &DecimalValue{Unscaled: big.NewInt(v.Value), Scale: 0}, true
+	}
+	return nil, false
+}
+
+func powerOfTen(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+// Rescales both operands to the larger scale ---
+func alignDecimals(left *DecimalValue, right *DecimalValue) (*big.Int, *big.Int, int) {
+	switch {
+	case left.Scale < right.Scale:
+		scaled := new(big.Int).Mul(left.Unscaled, powerOfTen(right.Scale-left.Scale))
+		return scaled, right.Unscaled, right.Scale
+	case left.Scale > right.Scale:
+		scaled := new(big.Int).Mul(right.Unscaled, powerOfTen(left.Scale-right.Scale))
+		return left.Unscaled, scaled, left.Scale
+	}
+	return left.Unscaled, right.Unscaled, left.Scale
+}
+
+// Divides two integers, rounding the quotient with the given mode ---
+func roundQuotient(numerator *big.Int, denominator *big.Int, mode string) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	negative := (numerator.Sign() < 0) != (denominator.Sign() < 0)
+	awayFromZero := false
+
+	switch mode {
+	case ROUND_UP:
+		awayFromZero = true
+	case ROUND_DOWN:
+		awayFromZero = false
+	case ROUND_CEILING:
+		awayFromZero = !negative
+	case ROUND_FLOOR:
+		awayFromZero = negative
+	default:
+		// Compare twice the remainder with the divisor to find the nearest ---
+		twice := new(big.Int).Abs(remainder)
+		twice.Lsh(twice, 1)
+		switch twice.Cmp(new(big.Int).Abs(denominator)) {
+		case 1:
+			awayFromZero = true
+		case 0:
+			awayFromZero = mode == ROUND_HALF_UP || (mode == ROUND_HALF_EVEN && quotient.Bit(0) == 1)
+		}
+	}
+
+	if awayFromZero {
+		if negative {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+// Rounds to the given number of fractional digits ---
+func roundDecimal(value *DecimalValue, places int, mode string) *DecimalValue {
+	if value.Scale <= places {
+		return value
+	}
+
+	rounded := roundQuotient(value.Unscaled, powerOfTen(value.Scale-places), mode)
+	return &DecimalValue{Unscaled: rounded, Scale: places}
+}
+
+// Drops trailing zeros, keeping at least minScale fractional digits ---
+func trimDecimal(value *DecimalValue, minScale int) *DecimalValue {
+	unscaled := new(big.Int).Set(value.Unscaled)
+	scale := value.Scale
+	ten := big.NewInt(10)
+	remainder := new(big.Int)
+
+	for scale > minScale {
+		quotient, _ := new(big.Int).QuoRem(unscaled, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		unscaled = quotient
+		scale--
+	}
+	return &DecimalValue{Unscaled: unscaled, Scale: scale}
+}
+
+// Keeps the context's precision in fractional digits, then drops the
+// trailing zeros past the operands' own scale: d"10.00" / 4 is 2.50 ---
+func (i *Interpreter) divideDecimals(left *DecimalValue, right *DecimalValue) RuntimeValue {
+	if right.Unscaled.Sign() == 0 {
+		i.errorHandler.Report(i.line, "Division by zero")
+		return NIL()
+	}
+
+	precision, rounding := i.decimals.settings()
+	scale := max(precision, left.Scale, right.Scale)
+
+	// left / right at `scale` digits is left.Unscaled * 10^shift / right.Unscaled ---
+	numerator := new(big.Int).Set(left.Unscaled)
+	denominator := new(big.Int).Set(right.Unscaled)
+	if shift := scale - left.Scale + right.Scale; shift >= 0 {
+		numerator.Mul(numerator, powerOfTen(shift))
+	} else {
+		denominator.Mul(denominator, powerOfTen(-shift))
+	}
+
+	quotient := &DecimalValue{Unscaled: roundQuotient(numerator, denominator, rounding), Scale: scale}
+	return trimDecimal(quotient, max(left.Scale, right.Scale))
+}
+
+func (i *Interpreter) evaluateDecimalBinaryExpression(left *DecimalValue, right *DecimalValue, operator *lexer.Token) RuntimeValue {
+	lhs, rhs, scale := alignDecimals(left, right)
+
+	switch operator.TokenType {
+	case lexer.PLUS:
+		return &DecimalValue{Unscaled: new(big.Int).Add(lhs, rhs), Scale: scale}
+	case lexer.MINUS:
+		return &DecimalValue{Unscaled: new(big.Int).Sub(lhs, rhs), Scale: scale}
+	case lexer.STAR:
+		return &DecimalValue{Unscaled: new(big.Int).Mul(left.Unscaled, right.Unscaled), Scale: left.Scale + right.Scale}
+	case lexer.SLASH:
+		return i.divideDecimals(left, right)
+	case lexer.TILDE_SLASH, lexer.MODULO:
+		if rhs.Sign() == 0 {
+			if operator.TokenType == lexer.MODULO {
+				i.errorHandler.Report(i.line, "Modulo by zero")
+			} else {
+				i.errorHandler.Report(i.line, "Division by zero")
+			}
+			return NIL()
+		}
+		if operator.TokenType == lexer.TILDE_SLASH {
+			return &DecimalValue{Unscaled: roundQuotient(lhs, rhs, ROUND_FLOOR), Scale: 0}
+		}
+		return &DecimalValue{Unscaled: floorRemainder(lhs, rhs), Scale: scale}
+	case lexer.STAR_STAR:
+		return i.decimalPower(left, right)
+	case lexer.LESS:
+		return BOOLEAN(lhs.Cmp(rhs) < 0)
+	case lexer.LESS_EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) <= 0)
+	case lexer.GREATER:
+		return BOOLEAN(lhs.Cmp(rhs) > 0)
+	case lexer.GREATER_EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) >= 0)
+	case lexer.EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) == 0)
+	case lexer.NOT_EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) != 0)
+	}
+
+	i.errorHandler.Report(i.line, fmt.Sprintf("Unsupported decimal binary operator: '%s'", operator.Lexeme))
+	return NIL()
+}
+
+// Whole exponents only; negative ones divide 1 by the power ---
+func (i *Interpreter) decimalPower(base *DecimalValue, exponent *DecimalValue) RuntimeValue {
+	whole := trimDecimal(exponent, 0)
+	if whole.Scale != 0 || !whole.Unscaled.IsInt64() {
+		i.errorHandler.ReportError(
+			"Interpreter-Decimal",
+			fmt.Sprintf("Decimal exponent must be a whole number, got %s", exponent.String()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return NIL()
+	}
+
+	power := whole.Unscaled.Int64()
+	magnitude := max(power, -power)
+	result := &DecimalValue{
+		Unscaled: new(big.Int).Exp(base.Unscaled, big.NewInt(magnitude), nil),
+		Scale:    base.Scale * int(magnitude),
+	}
+
+	if power < 0 {
+		return i.divideDecimals(&DecimalValue{Unscaled: big.NewInt(1), Scale: 0}, result)
+	}
+	return result
+}
+
+func (i *Interpreter) decimalSettings() *ObjectValue {
+	precision, rounding := i.decimals.settings()
+
+	settings := OBJECT(nil)
+	settings.Set("precision", &IntegerValue{Value: int64(precision)})
+	settings.Set("rounding", &StringValue{Value: rounding})
+	return settings
+}
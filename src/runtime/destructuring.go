@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Binds every name of a pattern as a new variable in env ---
+func (i *Interpreter) declarePattern(pattern ast.Pattern, value RuntimeValue, env Environment, isConstant bool) {
+	i.destructure(pattern, value, env, func(target ast.Pattern, value RuntimeValue) {
+		if identifier, ok := target.(*ast.IdentifierPattern); ok {
+			env.DeclareVariable(i.line, identifier.Name, value, isConstant, false)
+			return
+		}
+
+		i.errorHandler.ReportError(
+			"Interpreter-Destructure",
+			"Only variable names can be declared by a destructuring pattern",
+			i.line,
+			errorhandler.VariableDeclarationError,
+		)
+	})
+}
+
+// Writes every target of a pattern through the regular assignment paths ---
+func (i *Interpreter) assignPattern(pattern ast.Pattern, value RuntimeValue, env Environment) {
+	i.destructure(pattern, value, env, func(target ast.Pattern, value RuntimeValue) {
+		switch t := target.(type) {
+		case *ast.IdentifierPattern:
+			env.AssignVariable(i.line, t.Name, value)
+		case *ast.ExpressionPattern:
+			switch assignee := t.Target.(type) {
+			case *ast.MemberExpression:
+				i.assignToMember(assignee, value, lexer.ASSIGNMENT, env)
+			case *ast.IndexExpression:
+				i.assignToIndex(assignee, value, lexer.ASSIGNMENT, env)
+			}
+		}
+	})
+}
+
+// Walks a pattern against a value and hands each leaf target with its value to bind ---
+func (i *Interpreter) destructure(pattern ast.Pattern, value RuntimeValue, env Environment, bind func(ast.Pattern, RuntimeValue)) {
+	if i.errorHandler.HadError {
+		return
+	}
+
+	switch p := pattern.(type) {
+	case *ast.IdentifierPattern, *ast.ExpressionPattern:
+		bind(pattern, value)
+
+	case *ast.DefaultPattern:
+		if _, isNil := value.(*NilValue); isNil {
+			value = i.EvaluateExpression(p.Default, env)
+		}
+		i.destructure(p.Target, value, env, bind)
+
+	case *ast.RestPattern:
+		i.destructure(p.Target, value, env, bind)
+
+	case *ast.ArrayPattern:
+		array, ok := value.(*ArrayValue)
+		if !ok {
+			i.reportDestructureError("array", value)
+			return
+		}
+
+		elements := array.snapshot()
+		for idx, element := range p.Elements {
+			if element == nil {
+				continue // Skipped position ---
+			}
+
+			if rest, isRest := element.(*ast.RestPattern); isRest {
+				remaining := make([]RuntimeValue, 0)
+				if idx < len(elements) {
+					remaining = append(remaining, elements[idx:]...)
+				}
+				i.destructure(rest, ARRAY(remaining), env, bind)
+				return
+			}
+
+			var item RuntimeValue = NIL()
+			if idx < len(elements) {
+				item = elements[idx]
+			}
+			i.destructure(element, item, env, bind)
+		}
+
+	case *ast.ObjectPattern:
+		var fields *ObjectValue
+		switch v := value.(type) {
+		case *ObjectValue:
+			fields = v
+		case *InstanceValue:
+			fields = v.Fields
+		default:
+			i.reportDestructureError("object", value)
+			return
+		}
+
+		usedKeys := make([]string, 0, len(p.Properties))
+		for _, property := range p.Properties {
+			var item RuntimeValue = NIL()
+			if found, exists := i.getDestructuredProperty(value, property.Key); exists {
+				item = found
+			}
+
+			usedKeys = append(usedKeys, property.Key)
+			i.destructure(property.Value, item, env, bind)
+		}
+
+		if p.Rest != nil {
+			remaining := OBJECT(nil)
+			for _, property := range fields.properties() {
+				if !slices.Contains(usedKeys, property.Key) {
+					remaining.Properties = append(remaining.Properties, property)
+				}
+			}
+			i.destructure(p.Rest, remaining, env, bind)
+		}
+	}
+}
+
+func (i *Interpreter) getDestructuredProperty(value RuntimeValue, key string) (RuntimeValue, bool) {
+	switch v := value.(type) {
+	case *ObjectValue:
+		return v.Lookup(key)
+	case *InstanceValue:
+		return i.getInstanceMember(v, key)
+	}
+	return nil, false
+}
+
+func (i *Interpreter) reportDestructureError(expected string, value RuntimeValue) {
+	i.errorHandler.ReportError(
+		"Interpreter-Destructure",
+		fmt.Sprintf("Cannot destructure value of type '%s' with an %s pattern", value.Type(), expected),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+}
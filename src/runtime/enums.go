@@ -0,0 +1,125 @@
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+func (i *Interpreter) evaluateEnumDeclaration(stmt *ast.EnumDeclarationStatement, env Environment) RuntimeValue {
+	enum := &EnumValue{Name: stmt.Name}
+
+	for ordinal, variant := range stmt.Variants {
+		enum.Variants = append(enum.Variants, &EnumVariantValue{
+			Enum:    enum,
+			Name:    variant.Name,
+			Ordinal: ordinal,
+			Fields:  variant.Fields,
+		})
+	}
+
+	env.DeclareVariable(stmt.Line, stmt.Name, enum, true, false)
+	return enum
+}
+
+// Calling a variant constructor like `Result.Ok(5)` ---
+func (i *Interpreter) constructVariant(constructor *EnumVariantValue, args []RuntimeValue) RuntimeValue {
+	if !constructor.IsConstructor() {
+		i.errorHandler.ReportError(
+			"Interpreter-Enum",
+			fmt.Sprintf("Variant '%s.%s' has no associated values and cannot be called", constructor.Enum.Name, constructor.Name),
+			i.line,
+			errorhandler.NonFunctionExpressionError,
+		)
+		return NIL()
+	}
+
+	if len(args) != len(constructor.Fields) {
+		i.errorHandler.ReportError(
+			"Interpreter-Enum",
+			fmt.Sprintf("Variant '%s.%s' expects %d argument(s) but got %d", constructor.Enum.Name, constructor.Name, len(constructor.Fields), len(args)),
+			i.line,
+			errorhandler.ArgumentLengthError,
+		)
+		return NIL()
+	}
+
+	// Variant fields have no defaults, so named arguments cannot skip one ---
+	for idx, arg := range args {
+		if arg == nil {
+			i.errorHandler.ReportError(
+				"Interpreter-Enum",
+				fmt.Sprintf("Variant '%s.%s' is missing a value for '%s'", constructor.Enum.Name, constructor.Name, constructor.Fields[idx]),
+				i.line,
+				errorhandler.ArgumentLengthError,
+			)
+			return NIL()
+		}
+	}
+
+	values := make([]RuntimeValue, len(args))
+	copy(values, args)
+
+	return &EnumVariantValue{
+		Enum:    constructor.Enum,
+		Name:    constructor.Name,
+		Ordinal: constructor.Ordinal,
+		Fields:  constructor.Fields,
+		Values:  values,
+	}
+}
+
+func (i *Interpreter) getEnumMember(enum *EnumValue, property string) RuntimeValue {
+	if variant, exists := enum.FindVariant(property); exists {
+		return variant
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Enum",
+		fmt.Sprintf("Enum '%s' has no variant named '%s'", enum.Name, property),
+		i.line,
+		errorhandler.MemberExpressionError,
+	)
+	return NIL()
+}
+
+// Associated values are read by field name, then 'name' and 'ordinal' ---
+func (i *Interpreter) getVariantMember(variant *EnumVariantValue, property string) RuntimeValue {
+	for idx, field := range variant.Fields {
+		if field == property && idx < len(variant.Values) {
+			return variant.Values[idx]
+		}
+	}
+
+	switch property {
+	case "name":
+		return &StringValue{Value: variant.Name}
+	case "ordinal":
+		return &IntegerValue{Value: int64(variant.Ordinal)}
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Enum",
+		fmt.Sprintf("Variant '%s.%s' has no field named '%s'", variant.Enum.Name, variant.Name, property),
+		i.line,
+		errorhandler.MemberExpressionError,
+	)
+	return NIL()
+}
+
+func variantsEqual(left *EnumVariantValue, right *EnumVariantValue, leftOpen []RuntimeValue, rightOpen []RuntimeValue) bool {
+	if left.Enum != right.Enum || left.Ordinal != right.Ordinal || len(left.Values) != len(right.Values) {
+		return false
+	}
+	if left.IsConstructor() != right.IsConstructor() {
+		return false
+	}
+
+	for idx := range left.Values {
+		if !valuesEqualWithin(left.Values[idx], right.Values[idx], leftOpen, rightOpen) {
+			return false
+		}
+	}
+	return true
+}
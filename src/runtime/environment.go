@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
 	errorhandler "github.com/caelondev/lento/src/error-handler"
 )
@@ -11,6 +12,7 @@ import (
 type Environment interface {
 	DeclareVariable(line uint, variableName string, value RuntimeValue, isConstant bool, isNative bool)
 	AssignVariable(line uint, variableName string, value RuntimeValue)
+	SwapVariable(line uint, variableName string, expected RuntimeValue, value RuntimeValue) bool
 	LookupVariable(line uint, variableName string) RuntimeValue
 	ResolveVariable(line uint, variableName string) Environment
 	IsNative(variableName string) bool
@@ -22,6 +24,8 @@ type EnvironmentStruct struct {
 	constants    []string
 	natives      []string
 	errorHandler *errorhandler.ErrorHandler
+
+	mutex sync.RWMutex // Scopes can be shared by spawned tasks ---
 }
 
 func NewEnvironment(parent Environment, errorHandler *errorhandler.ErrorHandler) Environment {
@@ -108,9 +112,26 @@ func DeclareGlobalVariables(env Environment) {
 	env.DeclareVariable(0, "toLower", NATIVE_FUNCTION("toLower", NATIVE_TO_LOWER_FUNCTION), isConstant, isNative)
 	env.DeclareVariable(0, "str", NATIVE_FUNCTION("str", NATIVE_STR_FUNCTION), isConstant, isNative)
 	env.DeclareVariable(0, "num", NATIVE_FUNCTION("num", NATIVE_NUM_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "int", NATIVE_FUNCTION("int", NATIVE_INT_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "float", NATIVE_FUNCTION("float", NATIVE_FLOAT_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "bigint", NATIVE_FUNCTION("bigint", NATIVE_BIGINT_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "decimal", NATIVE_FUNCTION("decimal", NATIVE_DECIMAL_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "decimalContext", NATIVE_FUNCTION("decimalContext", NATIVE_DECIMAL_CONTEXT_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "channel", NATIVE_FUNCTION("channel", NATIVE_CHANNEL_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "wait", NATIVE_FUNCTION("wait", NATIVE_WAIT_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "rawGet", NATIVE_FUNCTION("rawGet", NATIVE_RAW_GET_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "rawSet", NATIVE_FUNCTION("rawSet", NATIVE_RAW_SET_FUNCTION), isConstant, isNative)
+
+	// Namespaces and collections
+	env.DeclareVariable(0, "Object", OBJECT_NAMESPACE(), isConstant, isNative)
+	env.DeclareVariable(0, "Map", NATIVE_FUNCTION("Map", NATIVE_MAP_FUNCTION), isConstant, isNative)
+	env.DeclareVariable(0, "Set", NATIVE_FUNCTION("Set", NATIVE_SET_FUNCTION), isConstant, isNative)
 }
 
 func (e *EnvironmentStruct) DeclareVariable(line uint, variableName string, value RuntimeValue, isConstant bool, isNative bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
 	_, exists := e.variables[variableName]
 
 	if exists {
@@ -133,6 +154,14 @@ func (e *EnvironmentStruct) DeclareVariable(line uint, variableName string, valu
 }
 
 func (e *EnvironmentStruct) AssignVariable(line uint, variableName string, value RuntimeValue) {
+	e.SwapVariable(line, variableName, nil, value)
+}
+
+// Assigns the variable only while it still holds expected (any value when
+// expected is nil), so read-modify-write updates made by several tasks
+// don't overwrite each other. Reports true once the update is settled,
+// including when it failed with an error ---
+func (e *EnvironmentStruct) SwapVariable(line uint, variableName string, expected RuntimeValue, value RuntimeValue) bool {
 	env := e.ResolveVariable(line, variableName)
 
 	if env == nil {
@@ -140,10 +169,12 @@ func (e *EnvironmentStruct) AssignVariable(line uint, variableName string, value
 			"Invalid left-hand assignment: '%s' is not defined",
 			variableName,
 		))
-		return
+		return true
 	}
 
 	envStruct := env.(*EnvironmentStruct)
+	envStruct.mutex.Lock()
+	defer envStruct.mutex.Unlock()
 
 	isNative := slices.Contains(envStruct.natives, variableName)
 	isConstant := slices.Contains(envStruct.constants, variableName)
@@ -153,7 +184,7 @@ func (e *EnvironmentStruct) AssignVariable(line uint, variableName string, value
 			"Cannot reassign keyword '%s'",
 			variableName,
 		))
-		return
+		return true
 	}
 
 	if isConstant {
@@ -161,10 +192,15 @@ func (e *EnvironmentStruct) AssignVariable(line uint, variableName string, value
 			"Cannot reassign constant '%s'",
 			variableName,
 		))
-		return
+		return true
+	}
+
+	if expected != nil && envStruct.variables[variableName] != expected {
+		return false
 	}
 
 	envStruct.variables[variableName] = value
+	return true
 }
 
 func (e *EnvironmentStruct) LookupVariable(line uint, variableName string) RuntimeValue {
@@ -179,11 +215,18 @@ func (e *EnvironmentStruct) LookupVariable(line uint, variableName string) Runti
 	}
 
 	envStruct := env.(*EnvironmentStruct)
+	envStruct.mutex.RLock()
+	defer envStruct.mutex.RUnlock()
+
 	return envStruct.variables[variableName]
 }
 
 func (e *EnvironmentStruct) ResolveVariable(line uint, variableName string) Environment {
-	if _, exists := e.variables[variableName]; exists {
+	e.mutex.RLock()
+	_, exists := e.variables[variableName]
+	e.mutex.RUnlock()
+
+	if exists {
 		return e
 	}
 
@@ -196,7 +239,11 @@ func (e *EnvironmentStruct) ResolveVariable(line uint, variableName string) Envi
 
 // Walk up scopes to check native
 func (e *EnvironmentStruct) IsNative(variableName string) bool {
-	if slices.Contains(e.natives, variableName) {
+	e.mutex.RLock()
+	isNative := slices.Contains(e.natives, variableName)
+	e.mutex.RUnlock()
+
+	if isNative {
 		return true
 	}
 
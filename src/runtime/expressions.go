@@ -3,6 +3,8 @@ package runtime
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"strings"
 
 	"github.com/caelondev/lento/src/ast"
 	errorhandler "github.com/caelondev/lento/src/error-handler"
@@ -19,6 +21,12 @@ func (i *Interpreter) EvaluateExpression(expr ast.Expression, env Environment) R
 	switch n := expr.(type) {
 	case *ast.NumberExpression:
 		return evaluateNumberExpression(n)
+	case *ast.IntegerExpression:
+		return evaluateIntegerExpression(n)
+	case *ast.BigIntExpression:
+		return evaluateBigIntExpression(n)
+	case *ast.DecimalExpression:
+		return evaluateDecimalExpression(n)
 	case *ast.StringExpression:
 		return evaluateStringExpression(n)
 	case *ast.ArrayExpression:
@@ -32,15 +40,46 @@ func (i *Interpreter) EvaluateExpression(expr ast.Expression, env Environment) R
 	case *ast.AssignmentExpression:
 		return i.evaluateAssignmentExpression(n, env)
 	case *ast.CallExpression:
-		return i.evaluateCallExpression(n, env)
+		return endChain(i.evaluateCallExpression(n, env))
 	case *ast.IndexExpression:
-		return i.evaluateIndexExpression(n, env)
+		return endChain(i.evaluateIndexExpression(n, env))
 	case *ast.ObjectExpression:
 		return i.evaluateObjectExpression(n, env)
+	case *ast.MapExpression:
+		return i.evaluateMapExpression(n, env)
+	case *ast.SetExpression:
+		return i.evaluateSetExpression(n, env)
 	case *ast.MemberExpression:
-		return i.evaluateMemberExpression(n, env)
+		return endChain(i.evaluateMemberExpression(n, env))
+	case *ast.SliceExpression:
+		return endChain(i.evaluateSliceExpression(n, env))
 	case *ast.PostfixExpression:
 		return i.evaluatePostfixExpression(n, env)
+	case *ast.FunctionExpression:
+		return i.evaluateFunctionExpression(n, env)
+	case *ast.RangeExpression:
+		return i.evaluateRangeExpression(n, env)
+	case *ast.TernaryExpression:
+		return i.evaluateTernaryExpression(n, env)
+	case *ast.ThisExpression:
+		return i.evaluateThisExpression(n, env)
+	case *ast.SuperExpression:
+		return i.evaluateSuperExpression(n, env)
+	case *ast.SpawnExpression:
+		return i.evaluateSpawnExpression(n, env)
+	case *ast.YieldExpression:
+		return i.evaluateYieldExpression(n, env)
+	case *ast.MatchExpression:
+		return i.evaluateMatchExpression(n, env)
+	case *ast.TemplateExpression:
+		return i.evaluateTemplateExpression(n, env)
+	case *ast.SpreadExpression:
+		i.errorHandler.ReportError(
+			"Interpreter-Spread",
+			"Spread syntax is only allowed in calls, arrays and objects",
+			i.line,
+			errorhandler.UnexpectedTokenError,
+		)
 
 	default:
 		i.errorHandler.Report(i.line, fmt.Sprintf("Unrecognized AST Expression whilst evaluating: %T\n", expr))
@@ -53,47 +92,113 @@ func evaluateNumberExpression(expr *ast.NumberExpression) RuntimeValue {
 	return &NumberValue{Value: expr.Value}
 }
 
+func evaluateIntegerExpression(expr *ast.IntegerExpression) RuntimeValue {
+	return &IntegerValue{Value: expr.Value}
+}
+
+func evaluateBigIntExpression(expr *ast.BigIntExpression) RuntimeValue {
+	value, _ := new(big.Int).SetString(expr.Value, 10)
+	return &BigIntValue{Value: value}
+}
+
+func evaluateDecimalExpression(expr *ast.DecimalExpression) RuntimeValue {
+	value, _ := parseDecimal(expr.Value)
+	return value
+}
+
 func evaluateSymbolExpression(expr *ast.SymbolExpression, env Environment) RuntimeValue {
 	return env.LookupVariable(expr.Line, expr.Value)
 }
 
 func evaluateStringExpression(expr *ast.StringExpression) RuntimeValue {
-	value := expr.Value[1 : len(expr.Value)-1]
-	return &StringValue{Value: value}
+	return &StringValue{Value: expr.Value}
 }
 
-func (i *Interpreter) evaluatePostfixExpression(expr *ast.PostfixExpression, env Environment) RuntimeValue {
-	symbol, ok := expr.Operand.(*ast.SymbolExpression)
-	if !ok {
+func (i *Interpreter) evaluateFunctionExpression(expr *ast.FunctionExpression, env Environment) RuntimeValue {
+	// Capture the current environment (closure)
+	return &FunctionValue{
+		Name:        ANONYMOUS_FUNCTION_NAME,
+		Parameters:  expr.Parameters,
+		Body:        expr.Body,
+		IsGenerator: expr.IsGenerator,
+		Environment: env,
+		File:        i.errorHandler.File,
+	}
+}
+
+func (i *Interpreter) evaluateRangeExpression(expr *ast.RangeExpression, env Environment) RuntimeValue {
+	bounds := []float64{0, 0, 1} // start, end, step ---
+	integral := true
+
+	for idx, boundExpr := range []ast.Expression{expr.Start, expr.End, expr.Step} {
+		if boundExpr == nil {
+			continue
+		}
+
+		bound := i.EvaluateExpression(boundExpr, env)
+		number, ok := toFloat(bound)
+		if !ok {
+			i.errorHandler.ReportError(
+				"Interpreter-Range",
+				fmt.Sprintf("Range bounds must be numbers, got '%s'", bound.Type()),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return NIL()
+		}
+		bounds[idx] = number
+		if _, isInteger := bound.(*IntegerValue); !isInteger {
+			integral = false
+		}
+	}
+
+	if bounds[2] == 0 {
 		i.errorHandler.ReportError(
-			"Interpreter-Postfix",
-			"Postfix operators can only be applied to variables",
+			"Interpreter-Range",
+			"Range step cannot be zero",
 			i.line,
-			errorhandler.InvalidPostfixExpressionError,
+			errorhandler.InvalidArgumentError,
 		)
 		return NIL()
 	}
 
-	currentValue := env.LookupVariable(i.line, symbol.Value)
+	return &RangeValue{Start: bounds[0], End: bounds[1], Step: bounds[2], Integral: integral, Inclusive: expr.Inclusive}
+}
 
-	numValue, ok := currentValue.(*NumberValue)
+func (i *Interpreter) evaluateTemplateExpression(expr *ast.TemplateExpression, env Environment) RuntimeValue {
+	var builder strings.Builder
+
+	for idx, text := range expr.Strings {
+		builder.WriteString(text)
+
+		if idx < len(expr.Expressions) {
+			value := i.EvaluateExpression(expr.Expressions[idx], env)
+			builder.WriteString(i.display(value))
+		}
+	}
+
+	return &StringValue{Value: builder.String()}
+}
+
+func (i *Interpreter) evaluatePostfixExpression(expr *ast.PostfixExpression, env Environment) RuntimeValue {
+	symbol, ok := expr.Operand.(*ast.SymbolExpression)
 	if !ok {
 		i.errorHandler.ReportError(
 			"Interpreter-Postfix",
-			fmt.Sprintf("Cannot apply postfix operator to non-number type '%s'", currentValue.Type()),
+			"Postfix operators can only be applied to variables",
 			i.line,
 			errorhandler.InvalidPostfixExpressionError,
 		)
 		return NIL()
 	}
 
-	oldValue := numValue.Value
-	var newValue float64
+	// Integers stay integers, floats stay floats ---
+	var step *lexer.Token
 	switch expr.Operator.TokenType {
 	case lexer.PLUS_PLUS:
-		newValue = numValue.Value + 1
+		step = &lexer.Token{TokenType: lexer.PLUS, Lexeme: "+"}
 	case lexer.MINUS_MINUS:
-		newValue = numValue.Value - 1
+		step = &lexer.Token{TokenType: lexer.MINUS, Lexeme: "-"}
 	default:
 		i.errorHandler.ReportError(
 			"Interpreter-Postfix",
@@ -103,32 +208,97 @@ func (i *Interpreter) evaluatePostfixExpression(expr *ast.PostfixExpression, env
 		)
 		return NIL()
 	}
-	env.AssignVariable(i.line, symbol.Value, &NumberValue{Value: newValue})
-	return &NumberValue{Value: oldValue}
+
+	currentValue, _ := i.updateVariable(symbol.Value, env, func(currentValue RuntimeValue) RuntimeValue {
+		if _, ok := toFloat(currentValue); !ok {
+			i.errorHandler.ReportError(
+				"Interpreter-Postfix",
+				fmt.Sprintf("Cannot apply postfix operator to non-number type '%s'", currentValue.Type()),
+				i.line,
+				errorhandler.InvalidPostfixExpressionError,
+			)
+			return NIL()
+		}
+		return i.applyBinaryOperator(currentValue, &IntegerValue{Value: 1}, step)
+	})
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+	return currentValue
 }
 
 func (i *Interpreter) evaluateObjectExpression(expr *ast.ObjectExpression, env Environment) RuntimeValue {
-	var properties []ObjectPropertyValue
+	object := OBJECT(nil)
 
 	for _, property := range expr.Properties {
+		if spread, ok := property.Value.(*ast.SpreadExpression); ok {
+			i.spreadIntoObject(object, i.EvaluateExpression(spread.Value, env))
+			continue
+		}
+
 		value := i.EvaluateExpression(property.Value, env)
-		properties = append(properties, ObjectPropertyValue{Key: property.Key, Value: value})
+		object.Set(property.Key, value)
 	}
 
-	return &ObjectValue{
-		Properties: properties,
+	return object
+}
+
+// Copies the properties of the spread source, later keys overriding earlier ones ---
+func (i *Interpreter) spreadIntoObject(object *ObjectValue, source RuntimeValue) {
+	var fields *ObjectValue
+
+	switch v := source.(type) {
+	case *ObjectValue:
+		fields = v
+	case *InstanceValue:
+		fields = v.Fields
+	case *NilValue:
+		return
+	default:
+		i.errorHandler.ReportError(
+			"Interpreter-Spread",
+			fmt.Sprintf("Cannot spread type '%s' into an object", source.Type()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return
+	}
+
+	for _, property := range fields.properties() {
+		object.Set(property.Key, property.Value)
 	}
 }
 
 func (i *Interpreter) evaluateArrayExpression(expr *ast.ArrayExpression, env Environment) RuntimeValue {
+	return &ArrayValue{Elements: i.evaluateElements(expr.Elements, env)}
+}
 
-	var elements []RuntimeValue
+// Evaluates array elements or call arguments, expanding any `...spread` in place ---
+func (i *Interpreter) evaluateElements(exprs []ast.Expression, env Environment) []RuntimeValue {
+	var values []RuntimeValue
+
+	for _, expr := range exprs {
+		spread, ok := expr.(*ast.SpreadExpression)
+		if !ok {
+			values = append(values, i.EvaluateExpression(expr, env))
+			continue
+		}
+
+		source := i.EvaluateExpression(spread.Value, env)
+		if _, isObject := source.(*ObjectValue); isObject {
+			i.errorHandler.ReportError(
+				"Interpreter-Spread",
+				"Cannot spread an object into an array or argument list",
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return values
+		}
 
-	for _, element := range expr.Elements {
-		elements = append(elements, i.EvaluateExpression(element, env))
+		values = append(values, i.collectValues(source)...)
 	}
 
-	return &ArrayValue{Elements: elements}
+	return values
 }
 
 func (i *Interpreter) evaluateUnaryExpression(expr *ast.UnaryExpression, env Environment) RuntimeValue {
@@ -137,19 +307,43 @@ func (i *Interpreter) evaluateUnaryExpression(expr *ast.UnaryExpression, env Env
 
 	switch operator {
 	case lexer.PLUS:
-		if num, ok := operand.(*NumberValue); ok {
+		switch num := operand.(type) {
+		case *NumberValue:
 			return &NumberValue{Value: +num.Value}
+		case *IntegerValue:
+			return &IntegerValue{Value: +num.Value}
+		case *BigIntValue, *DecimalValue:
+			return num
 		}
 		i.errorHandler.Report(i.line, "Unary '+' operator requires a number")
 	case lexer.MINUS:
-		if num, ok := operand.(*NumberValue); ok {
+		switch num := operand.(type) {
+		case *NumberValue:
 			return &NumberValue{Value: -num.Value}
+		case *IntegerValue:
+			return &IntegerValue{Value: -num.Value}
+		case *BigIntValue:
+			return &BigIntValue{Value: new(big.Int).Neg(num.Value)}
+		case *DecimalValue:
+			return &DecimalValue{Unscaled: new(big.Int).Neg(num.Unscaled), Scale: num.Scale}
+		}
+		if result, handled := i.callUnaryOverload(operand, NEGATE_OVERLOAD); handled {
+			return result
 		}
 		i.errorHandler.Report(i.line, "Unary '-' operator requires a number")
-	case lexer.NOT:
-		if b, ok := operand.(*BooleanValue); ok {
-			return BOOLEAN(!isTruthy(b))
+	case lexer.TILDE:
+		switch num := operand.(type) {
+		case *IntegerValue:
+			return &IntegerValue{Value: ^num.Value}
+		case *BigIntValue:
+			return &BigIntValue{Value: new(big.Int).Not(num.Value)}
+		}
+		if result, handled := i.callUnaryOverload(operand, INVERT_OVERLOAD); handled {
+			return result
 		}
+		i.errorHandler.Report(i.line, "Unary '~' operator requires an integer")
+	case lexer.NOT, lexer.BANG:
+		return BOOLEAN(!isTruthy(operand))
 	default:
 		i.errorHandler.Report(i.line, fmt.Sprintf("Unrecognized unary operator: %s", expr.Operator.Lexeme))
 	}
@@ -160,31 +354,40 @@ func (i *Interpreter) evaluateUnaryExpression(expr *ast.UnaryExpression, env Env
 func (i *Interpreter) evaluateBinaryExpression(expr *ast.BinaryExpression, env Environment) RuntimeValue {
 	operatorToken := expr.Operator
 	left := i.EvaluateExpression(expr.Left, env)
-	right := i.EvaluateExpression(expr.Right, env)
 
+	// Logical operators short-circuit and yield the operand that decided the result ---
 	switch operatorToken.TokenType {
 	case lexer.AND:
-		return BOOLEAN(isTruthy(left) && isTruthy(right))
+		if !isTruthy(left) {
+			return left
+		}
+		return i.EvaluateExpression(expr.Right, env)
 	case lexer.OR:
-		return BOOLEAN(isTruthy(left) || isTruthy(right))
-	default:
-		leftNum, leftIsNum := left.(*NumberValue)
-		rightNum, rightIsNum := right.(*NumberValue)
-
-		if leftIsNum && rightIsNum {
-			return i.evaluateNumericBinaryExpression(leftNum, rightNum, operatorToken)
+		if isTruthy(left) {
+			return left
+		}
+		return i.EvaluateExpression(expr.Right, env)
+	case lexer.NULLISH_COALESCING:
+		if _, isNil := left.(*NilValue); !isNil {
+			return left
 		}
+		return i.EvaluateExpression(expr.Right, env)
+	}
 
-		leftStr, leftIsStr := left.(*StringValue)
-		rightStr, rightIsStr := right.(*StringValue)
+	right := i.EvaluateExpression(expr.Right, env)
+	if i.errorHandler.HadError {
+		return NIL()
+	}
 
-		if leftIsStr && rightIsStr {
-			return i.evaluateStringBinaryExpression(leftStr, rightStr, operatorToken)
-		}
+	return i.applyBinaryOperator(left, right, operatorToken)
+}
 
-		i.errorHandler.Report(i.line, fmt.Sprintf("Cannot perform '%s' binary operator with unsupported type (%s to %s)", operatorToken.Lexeme, left.Type(), right.Type()))
+func (i *Interpreter) evaluateTernaryExpression(expr *ast.TernaryExpression, env Environment) RuntimeValue {
+	condition := i.EvaluateExpression(expr.Condition, env)
+	if isTruthy(condition) {
+		return i.EvaluateExpression(expr.Consequent, env)
 	}
-	return NIL()
+	return i.EvaluateExpression(expr.Alternate, env)
 }
 
 func (i *Interpreter) evaluateStringBinaryExpression(left *StringValue, right *StringValue, operator *lexer.Token) RuntimeValue {
@@ -226,7 +429,18 @@ func (i *Interpreter) evaluateNumericBinaryExpression(left *NumberValue, right *
 		if rhs == 0 {
 			i.errorHandler.Report(i.line, "Modulo by zero")
 		}
+		// Floored like `~/`: the result takes the sign of rhs ---
 		result = math.Mod(lhs, rhs)
+		if result != 0 && (result < 0) != (rhs < 0) {
+			result += rhs
+		}
+	case lexer.TILDE_SLASH:
+		if rhs == 0 {
+			i.errorHandler.Report(i.line, "Division by zero")
+		}
+		result = math.Floor(lhs / rhs)
+	case lexer.STAR_STAR:
+		result = math.Pow(lhs, rhs)
 	case lexer.LESS:
 		return BOOLEAN(lhs < rhs)
 	case lexer.LESS_EQUAL:
@@ -241,6 +455,10 @@ func (i *Interpreter) evaluateNumericBinaryExpression(left *NumberValue, right *
 		return BOOLEAN(lhs != rhs)
 
 	default:
+		if isBitwiseOperator(operator.TokenType) {
+			i.errorHandler.Report(i.line, fmt.Sprintf("Bitwise operator '%s' requires integers, not floats", operator.Lexeme))
+			break
+		}
 		i.errorHandler.Report(i.line, fmt.Sprintf("Unsupported numeric binary operator: '%s'", operator.Lexeme))
 	}
 
@@ -249,8 +467,17 @@ func (i *Interpreter) evaluateNumericBinaryExpression(left *NumberValue, right *
 
 func (i *Interpreter) evaluateAssignmentExpression(expr *ast.AssignmentExpression, env Environment) RuntimeValue {
 	operator := expr.Operator
+	if operator == lexer.NULLISH_COALESCING_EQUALS {
+		return i.evaluateNullishAssignment(expr, env)
+	}
+
 	value := i.EvaluateExpression(expr.Value, env)
 
+	if expr.Pattern != nil {
+		i.assignPattern(expr.Pattern, value, env)
+		return value
+	}
+
 	switch assignee := expr.Assignee.(type) {
 	case *ast.SymbolExpression:
 		return i.assignToSymbol(assignee, value, operator, env)
@@ -258,6 +485,8 @@ func (i *Interpreter) evaluateAssignmentExpression(expr *ast.AssignmentExpressio
 		return i.assignToIndex(assignee, value, operator, env)
 	case *ast.MemberExpression:
 		return i.assignToMember(assignee, value, operator, env)
+	case *ast.SliceExpression:
+		return i.assignToSlice(assignee, value, operator, env)
 	default:
 		i.errorHandler.Report(i.line, "Invalid left-hand assignment")
 		return NIL()
@@ -265,25 +494,47 @@ func (i *Interpreter) evaluateAssignmentExpression(expr *ast.AssignmentExpressio
 }
 
 func (i *Interpreter) assignToSymbol(assignee *ast.SymbolExpression, value RuntimeValue, operator lexer.TokenType, env Environment) RuntimeValue {
-	finalValue := i.computeAssignmentValue(assignee.Value, value, operator, env)
-	env.AssignVariable(i.line, assignee.Value, finalValue)
+	if operator == lexer.ASSIGNMENT {
+		env.AssignVariable(i.line, assignee.Value, value)
+		return value
+	}
+
+	_, finalValue := i.updateVariable(assignee.Value, env, func(currentValue RuntimeValue) RuntimeValue {
+		return i.applyCompoundOperator(currentValue, value, operator)
+	})
 	return finalValue
 }
 
 func (i *Interpreter) assignToIndex(assignee *ast.IndexExpression, value RuntimeValue, operator lexer.TokenType, env Environment) RuntimeValue {
 	target := i.EvaluateExpression(assignee.Expr, env)
 	index := i.EvaluateExpression(assignee.Index, env)
+	return i.assignToIndexOf(target, index, value, operator)
+}
 
+func (i *Interpreter) assignToIndexOf(target RuntimeValue, index RuntimeValue, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
 	// Handle array assignment
 	if arrayValue, ok := target.(*ArrayValue); ok {
 		return i.assignToArrayIndex(arrayValue, index, value, operator)
 	}
 
+	if mapValue, ok := target.(*MapValue); ok {
+		return i.assignToMapKey(mapValue, index, value, operator)
+	}
+
+	if result, hooked := i.assignThroughHook(target, index, value, operator, INDEX_HOOK); hooked {
+		return result
+	}
+
 	// Handle object assignment
 	if objValue, ok := target.(*ObjectValue); ok {
 		return i.assignToObjectKey(objValue, index, value, operator)
 	}
 
+	// Handle instance field assignment
+	if instance, ok := target.(*InstanceValue); ok {
+		return i.assignToObjectKey(instance.Fields, index, value, operator)
+	}
+
 	i.errorHandler.ReportError(
 		"Interpreter-Assignment",
 		fmt.Sprintf("Cannot index type '%s' for assignment", target.Type()),
@@ -295,9 +546,20 @@ func (i *Interpreter) assignToIndex(assignee *ast.IndexExpression, value Runtime
 
 func (i *Interpreter) assignToMember(assignee *ast.MemberExpression, value RuntimeValue, operator lexer.TokenType, env Environment) RuntimeValue {
 	object := i.EvaluateExpression(assignee.Object, env)
+	return i.assignToPropertyOf(object, assignee.Property, value, operator)
+}
+
+func (i *Interpreter) assignToPropertyOf(object RuntimeValue, property string, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
+	if result, hooked := i.assignThroughHook(object, &StringValue{Value: property}, value, operator, GET_HOOK); hooked {
+		return result
+	}
 
 	if objValue, ok := object.(*ObjectValue); ok {
-		return i.assignToObjectProperty(objValue, assignee.Property, value, operator)
+		return i.assignToObjectProperty(objValue, property, value, operator)
+	}
+
+	if instance, ok := object.(*InstanceValue); ok {
+		return i.assignToObjectProperty(instance.Fields, property, value, operator)
 	}
 
 	i.errorHandler.ReportError(
@@ -310,34 +572,41 @@ func (i *Interpreter) assignToMember(assignee *ast.MemberExpression, value Runti
 }
 
 func (i *Interpreter) assignToArrayIndex(arrayValue *ArrayValue, index RuntimeValue, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
-	indexValue, ok := index.(*NumberValue)
+	indexValue, ok := toInteger(index)
 	if !ok {
 		i.errorHandler.ReportError(
 			"Interpreter-Array",
-			fmt.Sprintf("Array index must be a number, got '%s'", index.Type()),
+			fmt.Sprintf("Array index must be an integer, got '%s'", index.Type()),
 			i.line,
 			errorhandler.ArrayIndexError,
 		)
 		return NIL()
 	}
 
-	idx := int(indexValue.Value)
-	if idx < 0 || idx >= len(arrayValue.Elements) {
-		i.errorHandler.ReportError(
-			"Interpreter-Array",
-			fmt.Sprintf("Index %d out of bounds for array of length %d", idx, len(arrayValue.Elements)),
-			i.line,
-			errorhandler.ArrayIndexError,
-		)
-		return NIL()
-	}
+	// The element is only replaced if no other task changed it while the
+	// new value was computed, otherwise it is computed again ---
+	idx := int(indexValue)
+	for {
+		currentValue, exists := arrayValue.at(idx)
+		if !exists {
+			i.errorHandler.ReportError(
+				"Interpreter-Array",
+				fmt.Sprintf("Index %d out of bounds for array of length %d", idx, arrayValue.length()),
+				i.line,
+				errorhandler.ArrayIndexError,
+			)
+			return NIL()
+		}
 
-	// Get current value for compound assignments
-	currentValue := arrayValue.Elements[idx]
-	finalValue := i.applyCompoundOperator(currentValue, value, operator)
+		finalValue := i.applyCompoundOperator(currentValue, value, operator)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
 
-	arrayValue.Elements[idx] = finalValue
-	return finalValue
+		if arrayValue.swap(idx, currentValue, finalValue) {
+			return finalValue
+		}
+	}
 }
 
 func (i *Interpreter) assignToObjectKey(objValue *ObjectValue, index RuntimeValue, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
@@ -352,65 +621,64 @@ func (i *Interpreter) assignToObjectKey(objValue *ObjectValue, index RuntimeValu
 		return NIL()
 	}
 
-	// Search for existing key
-	for idx, prop := range objValue.Properties {
-		if prop.Key == keyValue.Value {
-			currentValue := prop.Value
-			finalValue := i.applyCompoundOperator(currentValue, value, operator)
-			objValue.Properties[idx].Value = finalValue
-			return finalValue
-		}
-	}
+	return i.assignToObjectProperty(objValue, keyValue.Value, value, operator)
+}
 
-	// Key doesn't exist - only allow = operator for new properties
-	if operator != lexer.ASSIGNMENT {
-		i.errorHandler.Report(i.line,
-			fmt.Sprintf("Cannot use compound assignment on undefined property '%s'", keyValue.Value))
-		return NIL()
+func (i *Interpreter) assignToObjectProperty(objValue *ObjectValue, property string, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
+	if operator == lexer.ASSIGNMENT {
+		objValue.Set(property, value)
+		return value
 	}
 
-	// Add new property
-	objValue.Properties = append(objValue.Properties, ObjectPropertyValue{
-		Key:   keyValue.Value,
-		Value: value,
-	})
-	return value
-}
+	// Compound operators may start from an inherited value, the result is
+	// always stored on the object itself. Recomputed if another task
+	// changed the property meanwhile ---
+	for {
+		currentValue, own := objValue.Get(property)
+		if !own {
+			inherited, exists := objValue.Lookup(property)
+			if !exists {
+				i.errorHandler.Report(i.line,
+					fmt.Sprintf("Cannot use compound assignment on undefined property '%s'", property))
+				return NIL()
+			}
+			currentValue = inherited
+		}
 
-func (i *Interpreter) assignToObjectProperty(objValue *ObjectValue, property string, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
-	// Search for existing property
-	for idx, prop := range objValue.Properties {
-		if prop.Key == property {
-			currentValue := prop.Value
-			finalValue := i.applyCompoundOperator(currentValue, value, operator)
-			objValue.Properties[idx].Value = finalValue
+		finalValue := i.applyCompoundOperator(currentValue, value, operator)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+
+		expected := currentValue
+		if !own {
+			expected = nil // Must still be missing from the object itself ---
+		}
+		if objValue.swap(property, expected, finalValue) {
 			return finalValue
 		}
 	}
+}
 
-	// Property doesn't exist - only allow = operator for new properties
-	if operator != lexer.ASSIGNMENT {
-		i.errorHandler.Report(i.line,
-			fmt.Sprintf("Cannot use compound assignment on undefined property '%s'", property))
-		return NIL()
-	}
+// Read-modify-write of a variable, returning its old and new value.
+// update runs without holding the scope's lock (it may call overloads)
+// and runs again if another task assigned the variable in the meantime ---
+func (i *Interpreter) updateVariable(varName string, env Environment, update func(currentValue RuntimeValue) RuntimeValue) (RuntimeValue, RuntimeValue) {
+	for {
+		currentValue := env.LookupVariable(i.line, varName)
+		if i.errorHandler.HadError {
+			return NIL(), NIL()
+		}
 
-	// Add new property
-	objValue.Properties = append(objValue.Properties, ObjectPropertyValue{
-		Key:   property,
-		Value: value,
-	})
-	return value
-}
+		newValue := update(currentValue)
+		if i.errorHandler.HadError {
+			return currentValue, NIL()
+		}
 
-func (i *Interpreter) computeAssignmentValue(varName string, value RuntimeValue, operator lexer.TokenType, env Environment) RuntimeValue {
-	if operator == lexer.ASSIGNMENT {
-		return value
+		if env.SwapVariable(i.line, varName, currentValue, newValue) {
+			return currentValue, newValue
+		}
 	}
-
-	// For compound assignments, get current value
-	currentValue := env.LookupVariable(i.line, varName)
-	return i.applyCompoundOperator(currentValue, value, operator)
 }
 
 func (i *Interpreter) applyCompoundOperator(currentValue RuntimeValue, value RuntimeValue, operator lexer.TokenType) RuntimeValue {
@@ -418,139 +686,118 @@ func (i *Interpreter) applyCompoundOperator(currentValue RuntimeValue, value Run
 		return value
 	}
 
-	// Handle compound assignments
-	leftNum, leftIsNum := currentValue.(*NumberValue)
-	rightNum, rightIsNum := value.(*NumberValue)
-
-	if !leftIsNum || !rightIsNum {
-		i.errorHandler.Report(i.line,
-			"Compound assignment requires numeric operands")
-		return NIL()
-	}
-
-	switch operator {
-	case lexer.PLUS_EQUALS:
-		return &NumberValue{Value: leftNum.Value + rightNum.Value}
-	case lexer.MINUS_EQUALS:
-		return &NumberValue{Value: leftNum.Value - rightNum.Value}
-	case lexer.STAR_EQUALS:
-		return &NumberValue{Value: leftNum.Value * rightNum.Value}
-	case lexer.SLASH_EQUALS:
-		if rightNum.Value == 0 {
-			i.errorHandler.Report(i.line, "Division by zero")
-			return NIL()
-		}
-		return &NumberValue{Value: leftNum.Value / rightNum.Value}
-	case lexer.MODULO_EQUALS:
-		if rightNum.Value == 0 {
-			i.errorHandler.Report(i.line, "Modulo by zero")
-			return NIL()
-		}
-		return &NumberValue{Value: math.Mod(leftNum.Value, rightNum.Value)}
-	default:
+	// Compound assignments apply their binary operator, overloads included ---
+	binaryOperator, exists := COMPOUND_OPERATORS[operator]
+	if !exists {
 		i.errorHandler.Report(i.line,
 			"Unsupported assignment operator")
 		return NIL()
 	}
+
+	return i.applyBinaryOperator(currentValue, value, binaryOperator)
 }
 
 func (i *Interpreter) evaluateCallExpression(call *ast.CallExpression, env Environment) RuntimeValue {
-	caller := i.EvaluateExpression(call.Caller, env)
-
-	// Parse all string arguments ---
-	var args []RuntimeValue
-	for _, argExpr := range call.Arguments {
-		arg := i.EvaluateExpression(argExpr, env)
-
-		if i.errorHandler.HadError {
-			return NIL()
-		}
-
-		args = append(args, arg)
+	caller, args := i.prepareCall(call, env)
+	if i.errorHandler.HadError {
+		return NIL()
 	}
 
-	// Native function call ---
-	if nativeFunc, ok := caller.(*NativeFunctionValue); ok {
-		return nativeFunc.Call(args, env, i)
+	if _, skipped := caller.(*skippedChainValue); skipped {
+		return caller
 	}
 
-	// User defined function ---
-	if function, ok := caller.(*FunctionValue); ok {
-		if len(args) != len(function.Parameters) {
-			i.errorHandler.ReportError(
-				"Interpreter-Function",
-				fmt.Sprintf("Function '%s' expects %d argument(s) but got %d instead", function.Name, len(function.Parameters), len(args)),
-				i.line,
-				errorhandler.InvalidArgumentError,
-			)
-			return NIL()
-		}
+	return i.callValue(caller, args, env)
+}
 
-		// Create function scope with the captured environment as parent ---
-		functionScope := NewEnvironment(function.Environment, i.errorHandler)
+// Evaluates the callee and its arguments without calling it yet ---
+func (i *Interpreter) prepareCall(call *ast.CallExpression, env Environment) (RuntimeValue, []RuntimeValue) {
+	caller := i.evaluateChainTarget(call.Caller, env)
+	if skipsChain(caller, call.Optional) {
+		return &skippedChainValue{}, nil // f?.(x) leaves x unevaluated ---
+	}
 
-		// Bind parameters to arguments in the function scope ---
-		for idx, param := range function.Parameters {
-			functionScope.DeclareVariable(i.line, param, args[idx], false, false)
+	// Evaluate all arguments, keeping named ones apart ---
+	var positional []ast.Expression
+	var named []*ast.NamedArgumentExpression
+	for _, argExpr := range call.Arguments {
+		if namedArg, ok := argExpr.(*ast.NamedArgumentExpression); ok {
+			named = append(named, namedArg)
+		} else {
+			positional = append(positional, argExpr)
 		}
+	}
 
-		wasInFunction := i.isInFunction
-		i.isInFunction = true
-
-		// Execute body with the function scope
-		result := i.EvaluateStatement(function.Body, functionScope)
-
-		i.isInFunction = wasInFunction
-
-		if control, ok := result.(*ControlFlowValue); ok && control.GetFlowType() == FLOW_RETURN {
-			return control.Value
-		}
+	args := i.evaluateElements(positional, env)
+	if i.errorHandler.HadError {
+		return caller, nil
+	}
 
-		return result
+	if len(named) > 0 {
+		args = i.applyNamedArguments(caller, args, named, env)
 	}
 
-	i.errorHandler.ReportError(
-		"Interpreter-Function",
-		fmt.Sprintf("Cannot call non-function expression type '%s'", caller.Type()),
-		i.line,
-		errorhandler.NonFunctionExpressionError,
-	)
-	return NIL()
+	return caller, args
 }
 
 func (i *Interpreter) evaluateIndexExpression(expr *ast.IndexExpression, env Environment) RuntimeValue {
-	target := i.EvaluateExpression(expr.Expr, env)
+	target := i.evaluateChainTarget(expr.Expr, env)
+	if skipsChain(target, expr.Optional) {
+		return &skippedChainValue{}
+	}
 	index := i.EvaluateExpression(expr.Index, env)
+	return i.readIndex(target, index, expr.Optional)
+}
 
+// Reads target[index]; optional reads yield nil where they would fail ---
+func (i *Interpreter) readIndex(target RuntimeValue, index RuntimeValue, optional bool) RuntimeValue {
 	// Handle arrays
 	if arrayValue, ok := target.(*ArrayValue); ok {
-		indexValue, ok := index.(*NumberValue)
+		indexValue, ok := toInteger(index)
 		if !ok {
-			i.errorHandler.Report(i.line, "Array index must be a number")
+			i.errorHandler.Report(i.line, "Array index must be an integer")
 			return NIL()
 		}
 
-		idx := int(indexValue.Value)
-		if idx < 0 || idx >= len(arrayValue.Elements) {
+		idx := int(indexValue)
+		element, exists := arrayValue.at(idx)
+		if !exists {
+			if optional {
+				return NIL()
+			}
 			i.errorHandler.Report(i.line,
-				fmt.Sprintf("Index %d out of bounds for array of length %d", idx, len(arrayValue.Elements)))
+				fmt.Sprintf("Index %d out of bounds for array of length %d", idx, arrayValue.length()))
 			return NIL()
 		}
 
-		return arrayValue.Elements[idx]
+		return element
 	}
 
-	// Handle objects
-	if objValue, ok := target.(*ObjectValue); ok {
-		keyValue, ok := index.(*StringValue)
-		if !ok {
-			i.errorHandler.Report(i.line, "Object key must be a string")
-			return NIL()
+	// Missing map keys read as nil, like object keys ---
+	if mapValue, ok := target.(*MapValue); ok {
+		if value, exists := mapValue.Entries.get(index); exists {
+			return value
+		}
+		return NIL()
+	}
+
+	// Handle objects and instances, missing keys go through `__index` ---
+	if _, ok := rawFields(target); ok {
+		if keyValue, ok := index.(*StringValue); ok {
+			if value, exists := i.rawGet(target, keyValue.Value); exists {
+				return value
+			}
 		}
 
-		for _, prop := range objValue.Properties {
-			if prop.Key == keyValue.Value {
-				return prop.Value
+		if value, hooked := i.callHook(target, INDEX_HOOK, index); hooked {
+			return value
+		}
+
+		if _, ok := index.(*StringValue); !ok {
+			if _, isInstance := target.(*InstanceValue); isInstance {
+				i.errorHandler.Report(i.line, "Instance key must be a string")
+			} else {
+				i.errorHandler.Report(i.line, "Object key must be a string")
 			}
 		}
 
@@ -558,30 +805,108 @@ func (i *Interpreter) evaluateIndexExpression(expr *ast.IndexExpression, env Env
 		return NIL()
 	}
 
+	if optional {
+		return NIL()
+	}
+
 	i.errorHandler.Report(i.line,
 		fmt.Sprintf("Cannot index type '%s'", target.Type()))
 	return NIL()
 }
 
 func (i *Interpreter) evaluateMemberExpression(expr *ast.MemberExpression, env Environment) RuntimeValue {
-	object := i.EvaluateExpression(expr.Object, env)
+	object := i.evaluateChainTarget(expr.Object, env)
+	if skipsChain(object, expr.Optional) {
+		return &skippedChainValue{}
+	}
+	return i.readMember(object, expr.Property, expr.Optional)
+}
 
+// Reads object.property; optional reads yield nil where they would fail ---
+func (i *Interpreter) readMember(object RuntimeValue, property string, optional bool) RuntimeValue {
 	if obj, ok := object.(*ObjectValue); ok {
-		for _, property := range obj.Properties {
-			if property.Key == expr.Property {
-				return property.Value
-			}
+		if value, exists := i.getObjectMember(obj, property); exists {
+			return value
+		}
+
+		if value, exists := i.getBuiltinMember(obj, property); exists {
+			return value
+		}
+
+		if value, hooked := i.callHook(obj, GET_HOOK, &StringValue{Value: property}); hooked {
+			return value
+		}
+
+		if optional {
+			return NIL()
+		}
+
+		i.errorHandler.ReportError(
+			"Interpreter-Member",
+			fmt.Sprintf("Cannot access property of an object as it is undefined (reading property '%s', type of %s)", property, object.Type()),
+			i.line,
+			errorhandler.MemberExpressionError,
+		)
+		return NIL()
+	}
+
+	if instance, ok := object.(*InstanceValue); ok {
+		if value, exists := i.getInstanceMember(instance, property); exists {
+			return value
+		}
+
+		if value, hooked := i.callHook(instance, GET_HOOK, &StringValue{Value: property}); hooked {
+			return value
+		}
+
+		if optional {
+			return NIL()
 		}
 
 		i.errorHandler.ReportError(
 			"Interpreter-Member",
-			fmt.Sprintf("Cannot access property of an object as it is undefined (reading property '%s', type of %s)", expr.Property, object.Type()),
+			fmt.Sprintf("Cannot access property of an instance as it is undefined (reading property '%s' of %s)", property, instance.Class.Name),
 			i.line,
 			errorhandler.MemberExpressionError,
 		)
 		return NIL()
 	}
 
+	if module, ok := object.(*ModuleValue); ok {
+		if value, exists := module.Exports.Get(property); exists {
+			return value
+		}
+
+		if optional {
+			return NIL()
+		}
+
+		i.errorHandler.ReportError(
+			"Interpreter-Member",
+			fmt.Sprintf("Module '%s' has no export named '%s'", module.Path, property),
+			i.line,
+			errorhandler.ModuleError,
+		)
+		return NIL()
+	}
+
+	if enum, ok := object.(*EnumValue); ok {
+		return i.getEnumMember(enum, property)
+	}
+
+	if variant, ok := object.(*EnumVariantValue); ok {
+		return i.getVariantMember(variant, property)
+	}
+
+	// Strings, arrays, numbers, channels... ---
+	if value, exists := i.getBuiltinMember(object, property); exists {
+		return value
+	}
+
+	if optional {
+		return NIL()
+	}
+
 	i.errorHandler.ReportError(
 		"Interpreter-Member",
 		fmt.Sprintf("Cannot access property of non-object expression (type of %s)", object.Type()),
@@ -590,3 +915,31 @@ func (i *Interpreter) evaluateMemberExpression(expr *ast.MemberExpression, env E
 	)
 	return NIL()
 }
+
+// Own or inherited property of an object. Functions come back bound to
+// the object the property was read from, unless they already have a 'this'
+// (bound methods, closures created inside methods) ---
+func (i *Interpreter) getObjectMember(object *ObjectValue, name string) (RuntimeValue, bool) {
+	value, exists := object.Lookup(name)
+	if !exists {
+		return nil, false
+	}
+
+	if function, ok := value.(*FunctionValue); ok && function.Environment.ResolveVariable(i.line, THIS_BINDING) == nil {
+		return i.bindMethod(function, object), true
+	}
+	return value, true
+}
+
+// Fields shadow methods; methods come back bound to the instance ---
+func (i *Interpreter) getInstanceMember(instance *InstanceValue, name string) (RuntimeValue, bool) {
+	if value, exists := instance.Fields.Get(name); exists {
+		return value, true
+	}
+
+	if method, exists := instance.Class.FindMethod(name); exists {
+		return i.bindMethod(method, instance), true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,242 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+func (i *Interpreter) callValue(caller RuntimeValue, args []RuntimeValue, env Environment) RuntimeValue {
+	switch callee := caller.(type) {
+	case *NativeFunctionValue:
+		return callee.Call(args, env, i)
+	case *FunctionValue:
+		return i.callFunction(callee, args)
+	case *ClassValue:
+		return i.instantiateClass(callee, args)
+	case *EnumVariantValue:
+		return i.constructVariant(callee, args)
+	}
+
+	if result, hooked := i.callHook(caller, CALL_HOOK, args...); hooked {
+		return result
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Function",
+		fmt.Sprintf("Cannot call non-function expression type '%s'", caller.Type()),
+		i.line,
+		errorhandler.NonFunctionExpressionError,
+	)
+	return NIL()
+}
+
+// Calls a user defined function. A nil entry in args marks a parameter
+// that was skipped by named arguments and falls back to its default ---
+func (i *Interpreter) callFunction(function *FunctionValue, args []RuntimeValue) RuntimeValue {
+	required, maximum := functionArity(function)
+
+	if len(args) < required || (maximum >= 0 && len(args) > maximum) {
+		i.reportArityError(function, required, maximum, len(args))
+		return NIL()
+	}
+
+	// Errors from here on belong to the file that defined the function,
+	// which differs from the caller's for imported functions ---
+	callerFile := i.errorHandler.File
+	i.errorHandler.File = function.File
+	defer func() { i.errorHandler.File = callerFile }()
+
+	// Create function scope with the captured environment as parent ---
+	functionScope := NewEnvironment(function.Environment, i.errorHandler)
+
+	// Bind parameters to arguments in the function scope. Defaults are
+	// evaluated here so they can refer to earlier parameters ---
+	for idx, param := range function.Parameters {
+		if _, isRest := param.(*ast.RestPattern); isRest {
+			rest := make([]RuntimeValue, 0)
+			if idx < len(args) {
+				rest = append(rest, args[idx:]...)
+			}
+			i.declarePattern(param, ARRAY(rest), functionScope, false)
+			break
+		}
+
+		var arg RuntimeValue
+		if idx < len(args) {
+			arg = args[idx]
+		}
+
+		if arg == nil {
+			if _, hasDefault := param.(*ast.DefaultPattern); !hasDefault {
+				i.errorHandler.ReportError(
+					"Interpreter-Function",
+					fmt.Sprintf("Function '%s' is missing an argument for parameter '%s'", function.Name, parameterName(param)),
+					i.line,
+					errorhandler.InvalidArgumentError,
+				)
+				return NIL()
+			}
+			arg = NIL()
+		}
+
+		i.declarePattern(param, arg, functionScope, false)
+	}
+
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	if function.IsGenerator {
+		return i.newGenerator(function, functionScope)
+	}
+
+	wasInFunction := i.isInFunction
+	i.isInFunction = true
+
+	// A yield belongs to the generator body itself, not to functions it calls ---
+	generator := i.generator
+	i.generator = nil
+
+	// Execute body with the function scope
+	result := i.EvaluateStatement(function.Body, functionScope)
+
+	i.isInFunction = wasInFunction
+	i.generator = generator
+
+	if control, ok := result.(*ControlFlowValue); ok && control.GetFlowType() == FLOW_RETURN {
+		return control.Value
+	}
+
+	return result
+}
+
+// Returns how many arguments a function needs and accepts (-1 when variadic) ---
+func functionArity(function *FunctionValue) (int, int) {
+	required := 0
+	maximum := len(function.Parameters)
+
+	for idx, param := range function.Parameters {
+		switch param.(type) {
+		case *ast.RestPattern:
+			return required, -1
+		case *ast.DefaultPattern:
+		default:
+			required = idx + 1
+		}
+	}
+
+	return required, maximum
+}
+
+func (i *Interpreter) reportArityError(function *FunctionValue, required int, maximum int, got int) {
+	expected := fmt.Sprintf("%d", required)
+	switch {
+	case maximum < 0:
+		expected = fmt.Sprintf("at least %d", required)
+	case maximum != required:
+		expected = fmt.Sprintf("%d to %d", required, maximum)
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Function",
+		fmt.Sprintf("Function '%s' expects %s argument(s) but got %d instead", function.Name, expected, got),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+}
+
+// Slots named arguments into the positions of the matching parameters ---
+func (i *Interpreter) applyNamedArguments(caller RuntimeValue, args []RuntimeValue, named []*ast.NamedArgumentExpression, env Environment) []RuntimeValue {
+	var (
+		kind       string
+		name       string
+		parameters []string
+	)
+
+	switch callee := caller.(type) {
+	case *FunctionValue:
+		kind, name, parameters = "Function", callee.Name, parameterNames(callee)
+	case *ClassValue:
+		if initializer, exists := callee.FindMethod(CLASS_INITIALIZER); exists {
+			kind, name, parameters = "Function", initializer.Name, parameterNames(initializer)
+		}
+	case *EnumVariantValue:
+		if callee.IsConstructor() {
+			kind, name, parameters = "Variant", callee.Enum.Name+"."+callee.Name, callee.Fields
+		}
+	}
+
+	if kind == "" {
+		i.errorHandler.ReportError(
+			"Interpreter-Function",
+			fmt.Sprintf("Type '%s' does not accept named arguments", caller.Type()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return args
+	}
+
+	for _, arg := range named {
+		position := -1
+		for idx, param := range parameters {
+			if param != "" && param == arg.Name {
+				position = idx
+				break
+			}
+		}
+
+		if position < 0 {
+			i.errorHandler.ReportError(
+				"Interpreter-Function",
+				fmt.Sprintf("%s '%s' has no parameter named '%s'", kind, name, arg.Name),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return args
+		}
+
+		for len(args) <= position {
+			args = append(args, nil)
+		}
+
+		if args[position] != nil {
+			i.errorHandler.ReportError(
+				"Interpreter-Function",
+				fmt.Sprintf("Argument '%s' of %s '%s' was passed more than once", arg.Name, strings.ToLower(kind), name),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return args
+		}
+
+		args[position] = i.EvaluateExpression(arg.Value, env)
+	}
+
+	return args
+}
+
+// Names of the parameters that can be passed by name, rest parameters
+// and destructuring patterns are left blank ---
+func parameterNames(function *FunctionValue) []string {
+	names := make([]string, len(function.Parameters))
+	for idx, param := range function.Parameters {
+		if _, isRest := param.(*ast.RestPattern); !isRest {
+			names[idx] = parameterName(param)
+		}
+	}
+	return names
+}
+
+// Only plain identifier parameters (with or without a default) can be named ---
+func parameterName(param ast.Pattern) string {
+	switch p := param.(type) {
+	case *ast.IdentifierPattern:
+		return p.Name
+	case *ast.DefaultPattern:
+		return parameterName(p.Target)
+	}
+	return ""
+}
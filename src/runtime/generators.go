@@ -0,0 +1,187 @@
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+// Calling a `fn*` function binds its arguments but runs nothing yet ---
+func (i *Interpreter) newGenerator(function *FunctionValue, scope Environment) *GeneratorValue {
+	generator := &GeneratorValue{
+		Name:   function.Name,
+		body:   function.Body,
+		scope:  scope,
+		resume: make(chan RuntimeValue),
+		steps:  make(chan generatorStep),
+		file:   function.File,
+	}
+
+	forked := *i
+	forked.generator = generator
+	forked.thrown = nil
+	forked.isInFunction = true
+	forked.isInLoop = false
+	generator.interpreter = &forked
+
+	return generator
+}
+
+func (g *GeneratorValue) run() {
+	result := g.interpreter.EvaluateStatement(g.body, g.scope)
+
+	var value RuntimeValue = NIL()
+	if control, ok := result.(*ControlFlowValue); ok && control.GetFlowType() == FLOW_RETURN {
+		value = control.Value
+	}
+
+	g.steps <- generatorStep{value: value, done: true}
+}
+
+// Runs the generator until its next yield (or its end) and returns the
+// produced value. sent becomes the result of the paused yield ---
+func (i *Interpreter) resumeGenerator(generator *GeneratorValue, sent RuntimeValue) (RuntimeValue, bool) {
+	if generator.Done {
+		return NIL(), true
+	}
+
+	if generator.running {
+		i.errorHandler.ReportError(
+			"Interpreter-Generator",
+			fmt.Sprintf("Generator '%s' is already running", generator.Name),
+			i.line,
+			errorhandler.GeneratorError,
+		)
+		return NIL(), true
+	}
+
+	// The body sees the try statements around this call plus its own ---
+	outerDepth := i.errorHandler.CatchDepth()
+	i.errorHandler.SetCatchDepth(outerDepth + generator.catchDepth)
+	generator.running = true
+	line := i.line
+	callerFile := i.errorHandler.File
+	i.errorHandler.File = generator.file
+
+	if !generator.started {
+		generator.started = true
+		go generator.run()
+	} else {
+		generator.resume <- sent
+	}
+	step := <-generator.steps
+
+	generator.running = false
+	generator.catchDepth = i.errorHandler.CatchDepth() - outerDepth
+	i.errorHandler.SetCatchDepth(outerDepth)
+	i.errorHandler.File = callerFile
+	i.line = line
+
+	if step.done {
+		generator.Done = true
+	}
+
+	// A `throw` inside the body travels on to the caller ---
+	if i.errorHandler.HadError && generator.interpreter.thrown != nil {
+		i.thrown = generator.interpreter.thrown
+		generator.interpreter.thrown = nil
+	}
+
+	return step.value, step.done
+}
+
+func (i *Interpreter) evaluateYieldExpression(expr *ast.YieldExpression, env Environment) RuntimeValue {
+	if i.generator == nil {
+		i.errorHandler.ReportError(
+			"Interpreter-Generator",
+			"'yield' can only be used inside a generator function (fn*)",
+			i.line,
+			errorhandler.GeneratorError,
+		)
+		return NIL()
+	}
+
+	var value RuntimeValue = NIL()
+	if expr.Value != nil {
+		value = i.EvaluateExpression(expr.Value, env)
+	}
+
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	if i.generator.closing {
+		i.errorHandler.ReportError(
+			"Interpreter-Generator",
+			fmt.Sprintf("Generator '%s' cannot yield while it is being closed", i.generator.Name),
+			i.line,
+			errorhandler.GeneratorError,
+		)
+		return NIL()
+	}
+
+	// Hand the value to next() and wait until we are resumed ---
+	i.generator.steps <- generatorStep{value: value}
+	sent, open := <-i.generator.resume
+	if !open {
+		i.errorHandler.ReportError(
+			"Interpreter-Generator",
+			fmt.Sprintf("Generator '%s' was closed", i.generator.Name),
+			i.line,
+			errorhandler.GeneratorClosedError,
+		)
+		return NIL()
+	}
+	return sent
+}
+
+// Finishes a suspended generator early: the paused yield unwinds the
+// body, running its finally blocks, and the goroutine exits. Loops that
+// stop before the generator is done call this ---
+func (i *Interpreter) closeGenerator(generator *GeneratorValue) {
+	if generator.Done || generator.running {
+		return
+	}
+
+	generator.Done = true
+	if !generator.started {
+		return
+	}
+
+	// The loop may be exiting because of an error; keep it aside while
+	// the finally blocks run ---
+	var pending *errorhandler.Error
+	if i.errorHandler.HadError {
+		pending = i.errorHandler.Recover()
+	}
+
+	// One extra level keeps the closing signal from being printed ---
+	outerDepth := i.errorHandler.CatchDepth()
+	i.errorHandler.SetCatchDepth(outerDepth + generator.catchDepth + 1)
+	generator.running = true
+	generator.closing = true
+	line := i.line
+	callerFile := i.errorHandler.File
+	i.errorHandler.File = generator.file
+
+	close(generator.resume)
+	<-generator.steps
+
+	generator.running = false
+	i.errorHandler.File = callerFile
+	i.line = line
+
+	failure := i.errorHandler.Recover()
+	i.errorHandler.SetCatchDepth(outerDepth)
+
+	switch {
+	case pending != nil:
+		i.errorHandler.Restore(pending)
+	case failure != nil && failure.Code != errorhandler.GeneratorClosedError:
+		// A finally block failed; that error reaches the loop's caller ---
+		i.thrown = generator.interpreter.thrown
+		i.errorHandler.Raise(failure)
+	}
+	generator.interpreter.thrown = nil
+}
@@ -8,6 +8,12 @@ func isTruthy(value RuntimeValue) bool {
 		return v.Value
 	case *NumberValue:
 		return v.Value != 0
+	case *IntegerValue:
+		return v.Value != 0
+	case *BigIntValue:
+		return v.Value.Sign() != 0
+	case *DecimalValue:
+		return v.Unscaled.Sign() != 0
 	case *StringValue:
 		return v.Value != ""
 	default:
@@ -15,3 +21,77 @@ func isTruthy(value RuntimeValue) bool {
 	}
 }
 
+// Primitives, arrays and enum variants compare by value, everything else
+// by identity. Agrees with hashKey(), which Map and Set keys rely on ---
+func valuesEqual(left RuntimeValue, right RuntimeValue) bool {
+	return valuesEqualWithin(left, right, nil, nil)
+}
+
+// leftOpen/rightOpen hold the arrays and variants being compared further
+// up. A cyclic pair is equal when both sides loop back to the same depth ---
+func valuesEqualWithin(left RuntimeValue, right RuntimeValue, leftOpen []RuntimeValue, rightOpen []RuntimeValue) bool {
+	leftDepth, rightDepth := openDepth(leftOpen, left), openDepth(rightOpen, right)
+	if leftDepth >= 0 || rightDepth >= 0 {
+		return leftDepth == rightDepth
+	}
+
+	// Bigints and decimals equal any number of the same value ---
+	if isExactNumber(left) || isExactNumber(right) {
+		lhs, rhs := toRational(left), toRational(right)
+		return lhs != nil && rhs != nil && lhs.Cmp(rhs) == 0
+	}
+
+	switch l := left.(type) {
+	case *NilValue:
+		_, ok := right.(*NilValue)
+		return ok
+	case *BooleanValue:
+		r, ok := right.(*BooleanValue)
+		return ok && l.Value == r.Value
+	case *NumberValue:
+		r, ok := toFloat(right)
+		return ok && l.Value == r
+	case *IntegerValue:
+		if r, ok := right.(*IntegerValue); ok {
+			return l.Value == r.Value
+		}
+		r, ok := right.(*NumberValue)
+		return ok && float64(l.Value) == r.Value
+	case *StringValue:
+		r, ok := right.(*StringValue)
+		return ok && l.Value == r.Value
+	case *ArrayValue:
+		r, ok := right.(*ArrayValue)
+		if !ok {
+			return false
+		}
+
+		lhs, rhs := l.snapshot(), r.snapshot()
+		if len(lhs) != len(rhs) {
+			return false
+		}
+
+		leftOpen, rightOpen = append(leftOpen, l), append(rightOpen, r)
+		for idx := range lhs {
+			if !valuesEqualWithin(lhs[idx], rhs[idx], leftOpen, rightOpen) {
+				return false
+			}
+		}
+		return true
+	case *EnumVariantValue:
+		r, ok := right.(*EnumVariantValue)
+		return ok && variantsEqual(l, r, append(leftOpen, l), append(rightOpen, r))
+	default:
+		return left == right
+	}
+}
+
+// Position of value among the enclosing arrays and variants, or -1 ---
+func openDepth(open []RuntimeValue, value RuntimeValue) int {
+	for depth, enclosing := range open {
+		if enclosing == value {
+			return depth
+		}
+	}
+	return -1
+}
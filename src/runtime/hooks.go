@@ -0,0 +1,144 @@
+package runtime
+
+import (
+	"fmt"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Hooks objects and class instances can define to intercept how they
+// are read, written, called, measured and iterated ---
+const (
+	GET_HOOK   = "__get"   // obj.name when 'name' is missing ---
+	INDEX_HOOK = "__index" // obj[key] when 'key' is missing ---
+	SET_HOOK   = "__set"   // Every obj.name = value / obj[key] = value ---
+	CALL_HOOK  = "__call"
+	LEN_HOOK   = "__len"
+	ITER_HOOK  = "__iter"
+)
+
+// Calls a hook if the target defines it, reporting false otherwise.
+// The hook runs with 'this' bound to the target, objects included ---
+func (i *Interpreter) callHook(target RuntimeValue, hook string, args ...RuntimeValue) (RuntimeValue, bool) {
+	method, found := i.findOverload(target, hook)
+	if !found {
+		return nil, false
+	}
+
+	if args == nil {
+		args = []RuntimeValue{}
+	}
+	return i.callValue(method, args, i.globalEnv), true
+}
+
+// Property of an object (own or inherited) or member of an instance,
+// without hooks ---
+func (i *Interpreter) rawGet(target RuntimeValue, key string) (RuntimeValue, bool) {
+	switch t := target.(type) {
+	case *ObjectValue:
+		return i.getObjectMember(t, key)
+	case *InstanceValue:
+		return i.getInstanceMember(t, key)
+	}
+	return nil, false
+}
+
+func rawFields(target RuntimeValue) (*ObjectValue, bool) {
+	switch t := target.(type) {
+	case *ObjectValue:
+		return t, true
+	case *InstanceValue:
+		return t.Fields, true
+	}
+	return nil, false
+}
+
+// Routes an assignment through `__set` when the target defines it.
+// Compound operators read the current value first, hooks included ---
+func (i *Interpreter) assignThroughHook(target RuntimeValue, key RuntimeValue, value RuntimeValue, operator lexer.TokenType, readHook string) (RuntimeValue, bool) {
+	method, found := i.findOverload(target, SET_HOOK)
+	if !found {
+		return nil, false
+	}
+
+	if operator != lexer.ASSIGNMENT {
+		var current RuntimeValue
+		exists := false
+		if name, isString := key.(*StringValue); isString {
+			current, exists = i.rawGet(target, name.Value)
+		}
+		if !exists {
+			current, exists = i.callHook(target, readHook, key)
+		}
+
+		if !exists {
+			i.errorHandler.Report(i.line,
+				fmt.Sprintf("Cannot use compound assignment on undefined property '%s'", i.display(key)))
+			return NIL(), true
+		}
+
+		value = i.applyCompoundOperator(current, value, operator)
+		if i.errorHandler.HadError {
+			return NIL(), true
+		}
+	}
+
+	i.callValue(method, []RuntimeValue{key, value}, i.globalEnv)
+	return value, true
+}
+
+// Calls `__len` and checks it produced an integer ---
+func (i *Interpreter) callLenHook(target RuntimeValue) (RuntimeValue, bool) {
+	result, found := i.callHook(target, LEN_HOOK)
+	if !found {
+		return nil, false
+	}
+
+	if _, isInteger := result.(*IntegerValue); !isInteger && !i.errorHandler.HadError {
+		i.errorHandler.ReportError(
+			"Interpreter-Hook",
+			fmt.Sprintf("'%s' must return an integer, got '%s'", LEN_HOOK, result.Type()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return NIL(), true
+	}
+	return result, true
+}
+
+func NATIVE_RAW_GET_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 2 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "rawGet() expects exactly two arguments", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	key, isString := args[1].(*StringValue)
+	fields, hasFields := rawFields(args[0])
+	if !isString || !hasFields {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "rawGet() expects an object or instance and a string key", i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+
+	if value, exists := fields.Get(key.Value); exists {
+		return value
+	}
+	return NIL()
+}
+
+func NATIVE_RAW_SET_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 3 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "rawSet() expects exactly three arguments", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	key, isString := args[1].(*StringValue)
+	fields, hasFields := rawFields(args[0])
+	if !isString || !hasFields {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "rawSet() expects an object or instance, a string key and a value", i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+
+	fields.Set(key.Value, args[2])
+	return args[2]
+}
@@ -12,7 +12,18 @@ type Interpreter struct {
 	isInFunction bool
 	isInLoop     bool
 
+	thrown       RuntimeValue // Value of the pending `throw`, if any ---
 	line         uint
+
+	generator *GeneratorValue // Set while running a generator body ---
+
+	holdsImportLock bool // Set on interpreters evaluating a module for an import ---
+
+	modulePath string
+	modules    *moduleRegistry
+	exports    []string
+
+	decimals *decimalContext // Shared with forks and imported modules ---
 }
 
 func NewInterpreter(errorHandler *errorhandler.ErrorHandler, env Environment) *Interpreter {
@@ -20,6 +31,8 @@ func NewInterpreter(errorHandler *errorhandler.ErrorHandler, env Environment) *I
 		errorHandler: errorHandler,
 		globalEnv:    env,
 		line:         1,
+		modules:      newModuleRegistry(),
+		decimals:     newDecimalContext(),
 	}
 }
 
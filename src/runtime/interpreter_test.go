@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"testing"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+	"github.com/caelondev/lento/src/parser"
+)
+
+// A script together with the values its globals should display as, or
+// the error it should stop with ---
+type scriptTest struct {
+	name      string
+	source    string
+	want      map[string]string
+	wantError string
+}
+
+// Runs source on a fresh interpreter. Errors are held back instead of
+// printed so the test output stays readable ---
+func runScript(source string, path string) (*Interpreter, *errorhandler.ErrorHandler) {
+	handler := errorhandler.New()
+	handler.BeginCatch()
+
+	env := NewEnvironment(nil, handler)
+	interpreter := NewInterpreter(handler, env)
+	if path != "" {
+		handler.File = path
+		interpreter.SetModulePath(path)
+		defer interpreter.FinishModule()
+	}
+
+	tokens := lexer.NewLexer(source, handler).Tokenize()
+	if handler.HadError {
+		return interpreter, handler
+	}
+
+	program := parser.ProduceAST(tokens, handler)
+	if handler.HadError {
+		return interpreter, handler
+	}
+
+	// Top-level statements run in the global scope, as in lento.go ---
+	for _, statement := range program.Body {
+		interpreter.EvaluateStatement(statement, env)
+		if handler.HadError {
+			break
+		}
+	}
+	return interpreter, handler
+}
+
+func runScriptTests(t *testing.T, tests []scriptTest) {
+	t.Helper()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			interpreter, handler := runScript(test.source, "")
+			checkScript(t, interpreter, handler, test)
+		})
+	}
+}
+
+func checkScript(t *testing.T, interpreter *Interpreter, handler *errorhandler.ErrorHandler, test scriptTest) {
+	t.Helper()
+
+	if test.wantError != "" {
+		if !handler.HadError {
+			t.Fatalf("expected error %q, script ran without one", test.wantError)
+		}
+		if handler.LastError.Message != test.wantError {
+			t.Fatalf("expected error %q, got %q", test.wantError, handler.LastError.Message)
+		}
+		return
+	}
+
+	if handler.HadError {
+		t.Fatalf("unexpected error on line %d: %s", handler.LastError.Line, handler.LastError.Message)
+	}
+
+	for name, want := range test.want {
+		got := interpreter.display(interpreter.globalEnv.LookupVariable(0, name))
+		if got != want {
+			t.Errorf("%s: expected %q, got %q", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"fmt"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+// How many `__iter` hooks may hand the iteration on before giving up ---
+const MAX_ITER_HOOK_DEPTH = 64
+
+// The value actually iterated: `__iter` hands back the value to iterate
+// instead, which may define `__iter` itself ---
+func (i *Interpreter) resolveIterable(iterable RuntimeValue) RuntimeValue {
+	for depth := 0; depth < MAX_ITER_HOOK_DEPTH; depth++ {
+		replacement, hooked := i.callHook(iterable, ITER_HOOK)
+		if !hooked || i.errorHandler.HadError || replacement == iterable {
+			return iterable
+		}
+		iterable = replacement
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Iterate",
+		fmt.Sprintf("'%s' kept returning values with their own '%s' (more than %d in a row)", ITER_HOOK, ITER_HOOK, MAX_ITER_HOOK_DEPTH),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+	return NIL()
+}
+
+// Walks every (key, value) pair of an iterable value in order.
+// Returning false from the callback stops the iteration early ---
+func (i *Interpreter) iterate(iterable RuntimeValue, callback func(key, value RuntimeValue) bool) {
+	iterable = i.resolveIterable(iterable)
+	if i.errorHandler.HadError {
+		return
+	}
+
+	i.iterateResolved(iterable, callback)
+}
+
+// Like iterate, for a value resolveIterable already produced ---
+func (i *Interpreter) iterateResolved(iterable RuntimeValue, callback func(key, value RuntimeValue) bool) {
+	switch v := iterable.(type) {
+	case *ArrayValue:
+		for idx, element := range v.snapshot() {
+			if !callback(&IntegerValue{Value: int64(idx)}, element) {
+				return
+			}
+		}
+	case *StringValue:
+		for idx, char := range []rune(v.Value) {
+			if !callback(&IntegerValue{Value: int64(idx)}, &StringValue{Value: string(char)}) {
+				return
+			}
+		}
+	case *ObjectValue:
+		for _, property := range v.properties() {
+			if !callback(&StringValue{Value: property.Key}, property.Value) {
+				return
+			}
+		}
+	case *MapValue:
+		for _, entry := range v.Entries.snapshot() {
+			if !callback(entry.Key, entry.Value) {
+				return
+			}
+		}
+	case *SetValue:
+		for idx, entry := range v.Members.snapshot() {
+			if !callback(&IntegerValue{Value: int64(idx)}, entry.Key) {
+				return
+			}
+		}
+	case *EnumValue:
+		for _, variant := range v.Variants {
+			if !callback(&StringValue{Value: variant.Name}, variant) {
+				return
+			}
+		}
+	case *ChannelValue:
+		// Receives until the channel is closed and drained ---
+		for idx := 0; ; idx++ {
+			value, ok := i.receiveFromChannel(v)
+			if !ok {
+				return
+			}
+			if !callback(&IntegerValue{Value: int64(idx)}, value) {
+				return
+			}
+		}
+	case *GeneratorValue:
+		// Values are pulled one at a time, so endless generators are fine
+		// as long as the loop stops on its own ---
+		for idx := 0; ; idx++ {
+			value, done := i.resumeGenerator(v, NIL())
+			if done || i.errorHandler.HadError {
+				return
+			}
+			if !callback(&IntegerValue{Value: int64(idx)}, value) {
+				i.closeGenerator(v)
+				return
+			}
+		}
+	case *RangeValue:
+		idx := 0
+		for current := v.Start; v.contains(current); current += v.Step {
+			var value RuntimeValue = &NumberValue{Value: current}
+			if v.Integral {
+				value = &IntegerValue{Value: int64(current)}
+			}
+			if !callback(&IntegerValue{Value: int64(idx)}, value) {
+				return
+			}
+			idx++
+		}
+	default:
+		i.errorHandler.ReportError(
+			"Interpreter-Iterate",
+			fmt.Sprintf("Cannot iterate over non-iterable type '%s'", iterable.Type()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+	}
+}
+
+func (r *RangeValue) contains(value float64) bool {
+	if r.Inclusive && value == r.End {
+		return true
+	}
+	if r.Step > 0 {
+		return value < r.End
+	}
+	return value > r.End
+}
+
+// Collects every value an iterable yields, in order ---
+func (i *Interpreter) collectValues(iterable RuntimeValue) []RuntimeValue {
+	values := make([]RuntimeValue, 0)
+	i.iterate(iterable, func(_, value RuntimeValue) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
@@ -0,0 +1,180 @@
+package runtime
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+func (i *Interpreter) evaluateMatchExpression(expr *ast.MatchExpression, env Environment) RuntimeValue {
+	subject := i.EvaluateExpression(expr.Subject, env)
+
+	for _, arm := range expr.Arms {
+		bindings := make(map[string]RuntimeValue)
+		matched := i.matchPattern(arm.Pattern, subject, env, bindings)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		if !matched {
+			continue
+		}
+
+		// Pattern variables live in a scope of their own ---
+		armEnv := NewEnvironment(env, i.errorHandler)
+		for name, value := range bindings {
+			armEnv.DeclareVariable(i.line, name, value, false, false)
+		}
+
+		if arm.Guard != nil && !isTruthy(i.EvaluateExpression(arm.Guard, armEnv)) {
+			continue
+		}
+
+		return i.EvaluateStatement(arm.Body, armEnv)
+	}
+
+	i.line = expr.Line
+	i.errorHandler.ReportError(
+		"Interpreter-Match",
+		fmt.Sprintf("No match arm matched value '%s'", subject.String()),
+		i.line,
+		errorhandler.MatchError,
+	)
+	return NIL()
+}
+
+// Tests a value against a pattern, collecting the variables it binds.
+// Bindings are only meaningful when the whole pattern matched ---
+func (i *Interpreter) matchPattern(pattern ast.Pattern, value RuntimeValue, env Environment, bindings map[string]RuntimeValue) bool {
+	if i.errorHandler.HadError {
+		return false
+	}
+
+	switch p := pattern.(type) {
+	case *ast.WildcardPattern:
+		return true
+
+	case *ast.IdentifierPattern:
+		bindings[p.Name] = value
+		return true
+
+	case *ast.LiteralPattern:
+		return valuesEqual(i.EvaluateExpression(p.Value, env), value)
+
+	case *ast.VariantPattern:
+		expected, ok := i.EvaluateExpression(p.Variant, env).(*EnumVariantValue)
+		if !ok || !expected.IsConstructor() {
+			i.errorHandler.ReportError(
+				"Interpreter-Match",
+				"Only enum variants with associated values can be matched with '(...)'",
+				i.line,
+				errorhandler.MatchError,
+			)
+			return false
+		}
+
+		variant, ok := value.(*EnumVariantValue)
+		if !ok || variant.Enum != expected.Enum || variant.Ordinal != expected.Ordinal || variant.IsConstructor() {
+			return false
+		}
+
+		if len(p.Fields) != len(variant.Values) {
+			i.errorHandler.ReportError(
+				"Interpreter-Match",
+				fmt.Sprintf("Variant '%s.%s' has %d associated value(s) but the pattern lists %d", variant.Enum.Name, variant.Name, len(variant.Values), len(p.Fields)),
+				i.line,
+				errorhandler.MatchError,
+			)
+			return false
+		}
+
+		for idx, field := range p.Fields {
+			if !i.matchPattern(field, variant.Values[idx], env, bindings) {
+				return false
+			}
+		}
+		return true
+
+	case *ast.AlternativePattern:
+		for _, alternative := range p.Alternatives {
+			attempt := maps.Clone(bindings)
+			if i.matchPattern(alternative, value, env, attempt) {
+				maps.Copy(bindings, attempt)
+				return true
+			}
+		}
+		return false
+
+	case *ast.RestPattern:
+		return i.matchPattern(p.Target, value, env, bindings)
+
+	case *ast.ArrayPattern:
+		array, ok := value.(*ArrayValue)
+		if !ok {
+			return false
+		}
+
+		required := len(p.Elements)
+		hasRest := required > 0 && isRestPattern(p.Elements[required-1])
+		if hasRest {
+			required--
+		}
+
+		elements := array.snapshot()
+		if len(elements) < required || (!hasRest && len(elements) != required) {
+			return false
+		}
+
+		for idx := 0; idx < required; idx++ {
+			if !i.matchPattern(p.Elements[idx], elements[idx], env, bindings) {
+				return false
+			}
+		}
+
+		if hasRest {
+			remaining := append(make([]RuntimeValue, 0), elements[required:]...)
+			return i.matchPattern(p.Elements[required], ARRAY(remaining), env, bindings)
+		}
+		return true
+
+	case *ast.ObjectPattern:
+		var fields *ObjectValue
+		switch v := value.(type) {
+		case *ObjectValue:
+			fields = v
+		case *InstanceValue:
+			fields = v.Fields
+		default:
+			return false
+		}
+
+		usedKeys := make([]string, 0, len(p.Properties))
+		for _, property := range p.Properties {
+			item, exists := i.getDestructuredProperty(value, property.Key)
+			if !exists || !i.matchPattern(property.Value, item, env, bindings) {
+				return false
+			}
+			usedKeys = append(usedKeys, property.Key)
+		}
+
+		if p.Rest != nil {
+			remaining := OBJECT(nil)
+			for _, property := range fields.properties() {
+				if !slices.Contains(usedKeys, property.Key) {
+					remaining.Properties = append(remaining.Properties, property)
+				}
+			}
+			return i.matchPattern(p.Rest, remaining, env, bindings)
+		}
+		return true
+	}
+
+	return false
+}
+
+func isRestPattern(pattern ast.Pattern) bool {
+	_, ok := pattern.(*ast.RestPattern)
+	return ok
+}
@@ -0,0 +1,235 @@
+package runtime
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+	"github.com/caelondev/lento/src/parser"
+)
+
+// Shared by every interpreter spawned for the modules of one program ---
+type moduleRegistry struct {
+	cache   map[string]*ModuleValue
+	loading []string // Import chain currently being evaluated, for cycle detection ---
+
+	lock sync.Mutex // Imports from concurrent tasks load one at a time ---
+}
+
+func newModuleRegistry() *moduleRegistry {
+	return &moduleRegistry{
+		cache:   make(map[string]*ModuleValue),
+		loading: make([]string, 0),
+	}
+}
+
+// Sets the file the interpreter is running so imports resolve relative to it.
+// The file counts as loading, so a module importing it back is reported as a
+// cycle instead of running the script a second time ---
+func (i *Interpreter) SetModulePath(path string) {
+	if absolute, err := filepath.Abs(path); err == nil {
+		path = absolute
+	}
+	i.modulePath = path
+
+	i.modules.lock.Lock()
+	defer i.modules.lock.Unlock()
+	i.modules.loading = append(i.modules.loading, path)
+}
+
+// Called once the file given to SetModulePath has run; imports of it from
+// tasks that are still running then get its exports from the cache ---
+func (i *Interpreter) FinishModule() {
+	i.modules.lock.Lock()
+	defer i.modules.lock.Unlock()
+
+	i.modules.loading = slices.DeleteFunc(i.modules.loading, func(path string) bool {
+		return path == i.modulePath
+	})
+	i.modules.cache[i.modulePath] = i.moduleValue(i.modulePath)
+}
+
+func (i *Interpreter) evaluateImportStatement(stmt *ast.ImportStatement, env Environment) RuntimeValue {
+	module := i.loadModule(stmt.Path)
+	if module == nil {
+		return NIL()
+	}
+
+	if stmt.Alias != "" {
+		env.DeclareVariable(stmt.Line, stmt.Alias, module, true, false)
+	}
+
+	for _, name := range stmt.Names {
+		value, exists := module.Exports.Get(name)
+		if !exists {
+			i.errorHandler.ReportError(
+				"Interpreter-Import",
+				fmt.Sprintf("Module '%s' does not export '%s'", stmt.Path, name),
+				i.line,
+				errorhandler.ModuleError,
+			)
+			return NIL()
+		}
+
+		env.DeclareVariable(stmt.Line, name, value, true, false)
+	}
+
+	return module
+}
+
+func (i *Interpreter) evaluateExportStatement(stmt *ast.ExportStatement, env Environment) RuntimeValue {
+	if env != i.globalEnv {
+		i.errorHandler.ReportError(
+			"Interpreter-Export",
+			"Exports are only allowed at the top level of a module",
+			i.line,
+			errorhandler.ModuleError,
+		)
+		return NIL()
+	}
+
+	value := i.EvaluateStatement(stmt.Declaration, env)
+
+	switch decl := stmt.Declaration.(type) {
+	case *ast.VariableDeclarationStatement:
+		if decl.Pattern != nil {
+			i.exports = append(i.exports, ast.BoundNames(decl.Pattern)...)
+		} else {
+			i.exports = append(i.exports, decl.Identifier)
+		}
+	case *ast.FunctionDeclarationStatement:
+		i.exports = append(i.exports, decl.Name)
+	case *ast.ClassDeclarationStatement:
+		i.exports = append(i.exports, decl.Name)
+	case *ast.EnumDeclarationStatement:
+		i.exports = append(i.exports, decl.Name)
+	}
+
+	return value
+}
+
+func (i *Interpreter) loadModule(path string) *ModuleValue {
+	// Nested imports run on module interpreters that already hold the lock ---
+	if !i.holdsImportLock {
+		i.modules.lock.Lock()
+		defer i.modules.lock.Unlock()
+	}
+
+	resolved := i.resolveModulePath(path)
+
+	if module, cached := i.modules.cache[resolved]; cached {
+		return module
+	}
+
+	if slices.Contains(i.modules.loading, resolved) {
+		chain := append(slices.Clone(i.modules.loading), resolved)
+		for idx, link := range chain {
+			chain[idx] = displayModulePath(link)
+		}
+
+		i.errorHandler.ReportError(
+			"Interpreter-Import",
+			fmt.Sprintf("Circular import detected: %s", strings.Join(chain, " -> ")),
+			i.line,
+			errorhandler.ModuleError,
+		)
+		return nil
+	}
+
+	source, err := os.ReadFile(resolved)
+	if err != nil {
+		i.errorHandler.ReportError(
+			"Interpreter-Import",
+			fmt.Sprintf("Cannot read module '%s': %s", path, err.Error()),
+			i.line,
+			errorhandler.ModuleError,
+		)
+		return nil
+	}
+
+	i.modules.loading = append(i.modules.loading, resolved)
+	defer func() { i.modules.loading = i.modules.loading[:len(i.modules.loading)-1] }()
+
+	// Errors raised while the module runs should name the module's file ---
+	importingFile := i.errorHandler.File
+	i.errorHandler.File = displayModulePath(resolved)
+	defer func() { i.errorHandler.File = importingFile }()
+
+	tokens := lexer.NewLexer(string(source), i.errorHandler).Tokenize()
+	if i.errorHandler.HadError {
+		return nil
+	}
+
+	program := parser.ProduceAST(tokens, i.errorHandler)
+	if i.errorHandler.HadError {
+		return nil
+	}
+
+	moduleEnv := NewEnvironment(nil, i.errorHandler)
+	moduleInterpreter := NewInterpreter(i.errorHandler, moduleEnv)
+	moduleInterpreter.modules = i.modules
+	moduleInterpreter.decimals = i.decimals
+	moduleInterpreter.modulePath = resolved
+	moduleInterpreter.holdsImportLock = true
+
+	for _, statement := range program.Body {
+		moduleInterpreter.EvaluateStatement(statement, moduleEnv)
+		if i.errorHandler.HadError {
+			return nil
+		}
+	}
+
+	module := moduleInterpreter.moduleValue(resolved)
+	i.modules.cache[resolved] = module
+	return module
+}
+
+// Packs the names the interpreter's script exported ---
+func (i *Interpreter) moduleValue(path string) *ModuleValue {
+	exports := OBJECT(nil)
+	for _, name := range i.exports {
+		exports.Properties = append(exports.Properties, ObjectPropertyValue{
+			Key:   name,
+			Value: i.globalEnv.LookupVariable(0, name),
+		})
+	}
+
+	return &ModuleValue{
+		Path:    displayModulePath(path),
+		Exports: exports,
+	}
+}
+
+// Relative imports resolve against the importing file, or the working directory in the REPL ---
+func (i *Interpreter) resolveModulePath(path string) string {
+	if !filepath.IsAbs(path) {
+		base := "."
+		if i.modulePath != "" {
+			base = filepath.Dir(i.modulePath)
+		}
+		path = filepath.Join(base, path)
+	}
+
+	if absolute, err := filepath.Abs(path); err == nil {
+		return absolute
+	}
+	return path
+}
+
+func displayModulePath(path string) string {
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+
+	if relative, err := filepath.Rel(workingDir, path); err == nil && !strings.HasPrefix(relative, "..") {
+		return relative
+	}
+	return path
+}
@@ -0,0 +1,78 @@
+package runtime
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestImportCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		chain []string // Files named by the cycle error, empty when there is none ---
+		want  map[string]string
+	}{
+		{
+			name: "module importing the entry script",
+			files: map[string]string{
+				"main.lt": `import "./a.lt" as a; var x = a.y;`,
+				"a.lt":    `import "./main.lt" as main; export var y = 1;`,
+			},
+			chain: []string{"main.lt", "a.lt", "main.lt"},
+		},
+		{
+			name: "entry script importing itself",
+			files: map[string]string{
+				"main.lt": `import "./main.lt" as main;`,
+			},
+			chain: []string{"main.lt", "main.lt"},
+		},
+		{
+			name: "cycle between two imported modules",
+			files: map[string]string{
+				"main.lt": `import "./a.lt" as a;`,
+				"a.lt":    `import "./b.lt" as b; export var y = 1;`,
+				"b.lt":    `import "./a.lt" as a; export var z = 2;`,
+			},
+			chain: []string{"main.lt", "a.lt", "b.lt", "a.lt"},
+		},
+		{
+			name: "shared dependency is not a cycle",
+			files: map[string]string{
+				"main.lt":   `import "./a.lt" as a; import "./b.lt" as b; var x = a.y + b.z;`,
+				"a.lt":      `import "./shared.lt" as s; export var y = s.n;`,
+				"b.lt":      `import "./shared.lt" as s; export var z = s.n + 1;`,
+				"shared.lt": `export var n = 1;`,
+			},
+			want: map[string]string{"x": "3"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, source := range test.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			mainPath := filepath.Join(dir, "main.lt")
+			interpreter, handler := runScript(test.files["main.lt"], mainPath)
+
+			wantError := ""
+			if len(test.chain) > 0 {
+				chain := make([]string, len(test.chain))
+				for idx, name := range test.chain {
+					absolute, _ := filepath.Abs(filepath.Join(dir, name))
+					chain[idx] = displayModulePath(absolute)
+				}
+				wantError = "Circular import detected: " + strings.Join(chain, " -> ")
+			}
+
+			checkScript(t, interpreter, handler, scriptTest{want: test.want, wantError: wantError})
+		})
+	}
+}
@@ -2,33 +2,34 @@ package runtime
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	errorhandler "github.com/caelondev/lento/src/error-handler"
 )
 
 func NATIVE_PRINT_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	var output strings.Builder
 	for _, arg := range args {
-		if arg.Type() == "string" {
-			strValue := arg.String()[1 : len(arg.String())-1]
-			fmt.Print(strValue)
-		} else {
-			fmt.Print(arg)
-		}
+		output.WriteString(i.display(arg))
+	}
+	if i.errorHandler.HadError { // A __str method failed ---
+		return NIL()
 	}
-	fmt.Println()
+	fmt.Println(output.String())
 	return NIL()
 }
 
 func NATIVE_PRINTLN_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
 	for _, arg := range args {
-		if arg.Type() == "string" {
-			strValue := arg.String()[1 : len(arg.String())-1]
-			fmt.Println(strValue)
-		} else {
-			fmt.Println(arg)
+		line := i.display(arg)
+		if i.errorHandler.HadError {
+			return NIL()
 		}
+		fmt.Println(line)
 	}
 	return NIL()
 }
@@ -47,13 +48,24 @@ func NATIVE_LEN_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) R
 	arg := args[0]
 	switch arg.Type() {
 	case STRING_VALUE:
-		strValue := arg.String()[1 : len(arg.String())-1]
-		return &NumberValue{Value: float64(len(strValue))}
+		// Characters, like .length and slicing ---
+		return &IntegerValue{Value: int64(utf8.RuneCountInString(arg.(*StringValue).Value))}
 	case ARRAY_VALUE:
 		arr, _ := arg.(*ArrayValue)
-		return &NumberValue{Value: float64(len(arr.Elements))}
+		return &IntegerValue{Value: int64(arr.length())}
+	case MAP_VALUE:
+		return &IntegerValue{Value: int64(arg.(*MapValue).Entries.len())}
+	case SET_VALUE:
+		return &IntegerValue{Value: int64(arg.(*SetValue).Members.len())}
+	case GENERATOR_VALUE:
+		// Counting a generator runs it to the end ---
+		return &IntegerValue{Value: int64(len(i.collectValues(arg)))}
 
 	default:
+		if result, hooked := i.callLenHook(arg); hooked {
+			return result
+		}
+
 		i.errorHandler.ReportError(
 			"Interpreter-Native-Function",
 			fmt.Sprintf("Could not use len() on unsupported argument type (%s)", arg.Type()),
@@ -91,7 +103,7 @@ func NATIVE_STR_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) R
 	if val.Type() == "string" {
 		return val
 	}
-	return &StringValue{Value: val.String()}
+	return &StringValue{Value: i.display(val)}
 }
 
 func NATIVE_NUM_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
@@ -102,19 +114,269 @@ func NATIVE_NUM_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) R
 
 	val := args[0]
 	switch val.Type() {
-	case "number":
-		n, _ := strconv.ParseFloat(val.String(), 64)
-		return &NumberValue{Value: n}
+	case "number", "integer", "bigint", "decimal":
+		return val
 	case "string":
 		str := val.String()[1 : len(val.String())-1]
-		num, err := strconv.Atoi(str)
+		if num, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return &IntegerValue{Value: num}
+		}
+		if num, ok := new(big.Int).SetString(str, 10); ok { // Too large for an integer ---
+			return &BigIntValue{Value: num}
+		}
+		num, err := strconv.ParseFloat(str, 64)
 		if err != nil {
 			i.errorHandler.ReportError("Interpreter-Native-Function", "num() invalid string to convert", i.line, errorhandler.NativeFunctionError)
 			return NIL()
 		}
-		return &NumberValue{Value: float64(num)}
+		return &NumberValue{Value: num}
 	default:
 		i.errorHandler.ReportError("Interpreter-Native-Function", "num() can only convert number or string", i.line, "ERR_INT_TYPE")
 		return NIL()
 	}
 }
+
+// int() truncates floats towards zero and parses strings ---
+func NATIVE_INT_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "int() expects exactly one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	switch val := args[0].(type) {
+	case *IntegerValue:
+		return val
+	case *NumberValue:
+		if math.IsNaN(val.Value) || val.Value < math.MinInt64 || val.Value >= math.MaxInt64 {
+			i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("int() cannot convert %v to an integer", val.Value), i.line, errorhandler.NativeFunctionError)
+			return NIL()
+		}
+		return &IntegerValue{Value: int64(val.Value)}
+	case *BigIntValue, *DecimalValue:
+		whole, _ := toBigInt(NATIVE_BIGINT_FUNCTION(args, env, i))
+		if whole == nil || !whole.IsInt64() {
+			i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("int() cannot convert %s, it is too large for an integer", val.String()), i.line, errorhandler.NativeFunctionError)
+			return NIL()
+		}
+		return &IntegerValue{Value: whole.Int64()}
+	case *BooleanValue:
+		if val.Value {
+			return &IntegerValue{Value: 1}
+		}
+		return &IntegerValue{Value: 0}
+	case *StringValue:
+		if num, err := strconv.ParseInt(strings.TrimSpace(val.Value), 10, 64); err == nil {
+			return &IntegerValue{Value: num}
+		}
+		if num, err := strconv.ParseFloat(strings.TrimSpace(val.Value), 64); err == nil {
+			return NATIVE_INT_FUNCTION([]RuntimeValue{&NumberValue{Value: num}}, env, i)
+		}
+		i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("int() invalid string to convert: \"%s\"", val.Value), i.line, errorhandler.NativeFunctionError)
+		return NIL()
+	}
+
+	i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("int() cannot convert type '%s'", args[0].Type()), i.line, errorhandler.InvalidArgumentError)
+	return NIL()
+}
+
+func NATIVE_FLOAT_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "float() expects exactly one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	switch val := args[0].(type) {
+	case *NumberValue:
+		return val
+	case *IntegerValue, *BigIntValue, *DecimalValue:
+		num, _ := toFloat(val)
+		return &NumberValue{Value: num}
+	case *StringValue:
+		num, err := strconv.ParseFloat(strings.TrimSpace(val.Value), 64)
+		if err != nil {
+			i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("float() invalid string to convert: \"%s\"", val.Value), i.line, errorhandler.NativeFunctionError)
+			return NIL()
+		}
+		return &NumberValue{Value: num}
+	}
+
+	i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("float() cannot convert type '%s'", args[0].Type()), i.line, errorhandler.InvalidArgumentError)
+	return NIL()
+}
+
+// bigint() truncates decimals and accepts floats without a fraction ---
+func NATIVE_BIGINT_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "bigint() expects exactly one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	switch val := args[0].(type) {
+	case *BigIntValue:
+		return val
+	case *IntegerValue:
+		return &BigIntValue{Value: big.NewInt(val.Value)}
+	case *DecimalValue:
+		return &BigIntValue{Value: roundDecimal(val, 0, ROUND_DOWN).Unscaled}
+	case *NumberValue:
+		if math.IsNaN(val.Value) || math.IsInf(val.Value, 0) || val.Value != math.Trunc(val.Value) {
+			i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("bigint() cannot convert %v, it is not a whole number", val.Value), i.line, errorhandler.NativeFunctionError)
+			return NIL()
+		}
+		whole, _ := big.NewFloat(val.Value).Int(nil)
+		return &BigIntValue{Value: whole}
+	case *StringValue:
+		if num, ok := new(big.Int).SetString(strings.ReplaceAll(strings.TrimSpace(val.Value), "_", ""), 10); ok {
+			return &BigIntValue{Value: num}
+		}
+		i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("bigint() invalid string to convert: \"%s\"", val.Value), i.line, errorhandler.NativeFunctionError)
+		return NIL()
+	}
+
+	i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("bigint() cannot convert type '%s'", args[0].Type()), i.line, errorhandler.InvalidArgumentError)
+	return NIL()
+}
+
+// decimal(value) converts exactly, decimal(value, places) also rounds
+// with the current rounding mode ---
+func NATIVE_DECIMAL_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) < 1 || len(args) > 2 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "decimal() expects a value and optionally a number of places", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	var result *DecimalValue
+	switch val := args[0].(type) {
+	case *DecimalValue, *BigIntValue, *IntegerValue:
+		result, _ = toDecimal(val)
+	case *NumberValue:
+		if math.IsNaN(val.Value) || math.IsInf(val.Value, 0) {
+			i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("decimal() cannot convert %v", val.Value), i.line, errorhandler.NativeFunctionError)
+			return NIL()
+		}
+		// The shortest text that reads back as the float, so 0.1 stays 0.1 ---
+		result, _ = parseDecimal(strconv.FormatFloat(val.Value, 'f', -1, 64))
+	case *StringValue:
+		parsed, ok := parseDecimal(val.Value)
+		if !ok {
+			i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("decimal() invalid string to convert: \"%s\"", val.Value), i.line, errorhandler.NativeFunctionError)
+			return NIL()
+		}
+		result = parsed
+	default:
+		i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("decimal() cannot convert type '%s'", args[0].Type()), i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+
+	if len(args) == 2 {
+		places, ok := toInteger(args[1])
+		if !ok || places < 0 {
+			i.errorHandler.ReportError("Interpreter-Native-Function", "decimal() places must be a non-negative integer", i.line, errorhandler.InvalidArgumentError)
+			return NIL()
+		}
+
+		_, rounding := i.decimals.settings()
+		return roundDecimal(result, int(places), rounding)
+	}
+	return result
+}
+
+// decimalContext({ precision, rounding }) changes how decimal division
+// rounds. Both keys are optional; the current settings are returned ---
+func NATIVE_DECIMAL_CONTEXT_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) > 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "decimalContext() expects at most one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+	if len(args) == 0 {
+		return i.decimalSettings()
+	}
+
+	settings, ok := args[0].(*ObjectValue)
+	if !ok {
+		i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("decimalContext() expects an object, got '%s'", args[0].Type()), i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+
+	precision, rounding := i.decimals.settings()
+
+	if value, exists := settings.Get("precision"); exists {
+		places, ok := toInteger(value)
+		if !ok || places < 0 {
+			i.errorHandler.ReportError("Interpreter-Native-Function", "decimalContext() precision must be a non-negative integer", i.line, errorhandler.InvalidArgumentError)
+			return NIL()
+		}
+		precision = int(places)
+	}
+
+	if value, exists := settings.Get("rounding"); exists {
+		mode, ok := value.(*StringValue)
+		if !ok || !isRoundingMode(mode.Value) {
+			i.errorHandler.ReportError(
+				"Interpreter-Native-Function",
+				fmt.Sprintf("decimalContext() rounding must be one of %s", strings.Join(ROUNDING_MODES, ", ")),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return NIL()
+		}
+		rounding = mode.Value
+	}
+
+	i.decimals.update(precision, rounding)
+	return i.decimalSettings()
+}
+
+func NATIVE_CHANNEL_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) > 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "channel() expects at most one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	capacity := 0
+	if len(args) == 1 {
+		size, ok := toInteger(args[0])
+		if !ok || size < 0 {
+			i.errorHandler.ReportError("Interpreter-Native-Function", "channel() capacity must be a non-negative whole number", i.line, errorhandler.InvalidArgumentError)
+			return NIL()
+		}
+		capacity = int(size)
+	}
+
+	return &ChannelValue{
+		Channel:  make(chan RuntimeValue, capacity),
+		Capacity: capacity,
+	}
+}
+
+// wait(task) returns the task's result, wait([tasks]) an array of results ---
+func NATIVE_WAIT_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "wait() expects exactly one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	switch arg := args[0].(type) {
+	case *TaskValue:
+		return i.waitTask(arg)
+	case *ArrayValue:
+		elements := arg.snapshot()
+		results := make([]RuntimeValue, 0, len(elements))
+		for _, element := range elements {
+			task, ok := element.(*TaskValue)
+			if !ok {
+				i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("wait() expects an array of tasks, found '%s'", element.Type()), i.line, errorhandler.TaskError)
+				return NIL()
+			}
+
+			results = append(results, i.waitTask(task))
+			if i.errorHandler.HadError {
+				return NIL()
+			}
+		}
+		return ARRAY(results)
+	}
+
+	i.errorHandler.ReportError("Interpreter-Native-Function", fmt.Sprintf("wait() expects a task or an array of tasks, got '%s'", args[0].Type()), i.line, errorhandler.TaskError)
+	return NIL()
+}
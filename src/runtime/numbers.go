@@ -0,0 +1,303 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Every kind of number counts as a number; an integer mixed with a
+// float is promoted to a float ---
+func toFloat(value RuntimeValue) (float64, bool) {
+	switch v := value.(type) {
+	case *IntegerValue:
+		return float64(v.Value), true
+	case *NumberValue:
+		return v.Value, true
+	case *BigIntValue:
+		result, _ := new(big.Float).SetInt(v.Value).Float64()
+		return result, true
+	case *DecimalValue:
+		result, _ := toRational(v).Float64()
+		return result, true
+	}
+	return 0, false
+}
+
+// Integers of either size ---
+func toBigInt(value RuntimeValue) (*big.Int, bool) {
+	switch v := value.(type) {
+	case *BigIntValue:
+		return v.Value, true
+	case *IntegerValue:
+		return big.NewInt(v.Value), true
+	}
+	return nil, false
+}
+
+// Exact value of any number, nil for NaN and infinities ---
+func toRational(value RuntimeValue) *big.Rat {
+	switch v := value.(type) {
+	case *IntegerValue:
+		return new(big.Rat).SetInt64(v.Value)
+	case *BigIntValue:
+		return new(big.Rat).SetInt(v.Value)
+	case *DecimalValue:
+		return new(big.Rat).SetFrac(v.Unscaled, powerOfTen(v.Scale))
+	case *NumberValue:
+		return new(big.Rat).SetFloat64(v.Value)
+	}
+	return nil
+}
+
+func isExactNumber(value RuntimeValue) bool {
+	switch value.(type) {
+	case *BigIntValue, *DecimalValue:
+		return true
+	}
+	return false
+}
+
+// Integers, and floats without a fraction (`arr[2.0]`) ---
+func toInteger(value RuntimeValue) (int64, bool) {
+	switch v := value.(type) {
+	case *IntegerValue:
+		return v.Value, true
+	case *NumberValue:
+		if v.Value == math.Trunc(v.Value) && v.Value >= math.MinInt64 && v.Value <= math.MaxInt64 {
+			return int64(v.Value), true
+		}
+	case *BigIntValue:
+		if v.Value.IsInt64() {
+			return v.Value.Int64(), true
+		}
+	}
+	return 0, false
+}
+
+// Arithmetic involving a bigint or a decimal. A decimal turns integers
+// into decimals, a bigint turns integers into bigints. Reports false
+// when the other operand isn't a number ---
+func (i *Interpreter) evaluateExactBinaryExpression(left RuntimeValue, right RuntimeValue, operator *lexer.Token) (RuntimeValue, bool) {
+	_, leftIsDecimal := left.(*DecimalValue)
+	_, rightIsDecimal := right.(*DecimalValue)
+
+	if leftIsDecimal || rightIsDecimal {
+		_, leftIsFloat := left.(*NumberValue)
+		_, rightIsFloat := right.(*NumberValue)
+		if leftIsFloat || rightIsFloat {
+			i.errorHandler.ReportError(
+				"Interpreter-Decimal",
+				fmt.Sprintf("Cannot mix decimal and float in '%s', convert the float with decimal() first", operator.Lexeme),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return NIL(), true
+		}
+
+		lhs, leftOk := toDecimal(left)
+		rhs, rightOk := toDecimal(right)
+		if !leftOk || !rightOk {
+			return nil, false
+		}
+		return i.evaluateDecimalBinaryExpression(lhs, rhs, operator), true
+	}
+
+	if lhs, ok := toBigInt(left); ok {
+		if rhs, ok := toBigInt(right); ok {
+			return i.evaluateBigIntBinaryExpression(lhs, rhs, operator), true
+		}
+	}
+
+	// A bigint next to a float becomes a float ---
+	lhs, leftOk := toFloat(left)
+	rhs, rightOk := toFloat(right)
+	if !leftOk || !rightOk {
+		return nil, false
+	}
+	return i.evaluateNumericBinaryExpression(&NumberValue{Value: lhs}, &NumberValue{Value: rhs}, operator), true
+}
+
+// `/` never truncates, so dividing bigints gives a decimal ---
+func (i *Interpreter) evaluateBigIntBinaryExpression(lhs *big.Int, rhs *big.Int, operator *lexer.Token) RuntimeValue {
+	switch operator.TokenType {
+	case lexer.PLUS:
+		return &BigIntValue{Value: new(big.Int).Add(lhs, rhs)}
+	case lexer.MINUS:
+		return &BigIntValue{Value: new(big.Int).Sub(lhs, rhs)}
+	case lexer.STAR:
+		return &BigIntValue{Value: new(big.Int).Mul(lhs, rhs)}
+	case lexer.SLASH:
+		return i.divideDecimals(&DecimalValue{Unscaled: lhs, Scale: 0}, &DecimalValue{Unscaled: rhs, Scale: 0})
+	case lexer.TILDE_SLASH, lexer.MODULO:
+		if rhs.Sign() == 0 {
+			if operator.TokenType == lexer.MODULO {
+				i.errorHandler.Report(i.line, "Modulo by zero")
+			} else {
+				i.errorHandler.Report(i.line, "Division by zero")
+			}
+			return NIL()
+		}
+		if operator.TokenType == lexer.TILDE_SLASH {
+			return &BigIntValue{Value: roundQuotient(lhs, rhs, ROUND_FLOOR)}
+		}
+		return &BigIntValue{Value: floorRemainder(lhs, rhs)}
+	case lexer.STAR_STAR:
+		if rhs.Sign() < 0 {
+			return i.divideDecimals(&DecimalValue{Unscaled: big.NewInt(1), Scale: 0}, &DecimalValue{Unscaled: new(big.Int).Exp(lhs, new(big.Int).Neg(rhs), nil), Scale: 0})
+		}
+		return &BigIntValue{Value: new(big.Int).Exp(lhs, rhs, nil)}
+	case lexer.AMPERSAND:
+		return &BigIntValue{Value: new(big.Int).And(lhs, rhs)}
+	case lexer.PIPE:
+		return &BigIntValue{Value: new(big.Int).Or(lhs, rhs)}
+	case lexer.CARET:
+		return &BigIntValue{Value: new(big.Int).Xor(lhs, rhs)}
+	case lexer.SHIFT_LEFT, lexer.SHIFT_RIGHT:
+		if rhs.Sign() < 0 || !rhs.IsUint64() {
+			i.errorHandler.ReportError(
+				"Interpreter-Binary",
+				fmt.Sprintf("Invalid shift count %s", rhs.String()),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return NIL()
+		}
+		if operator.TokenType == lexer.SHIFT_LEFT {
+			return &BigIntValue{Value: new(big.Int).Lsh(lhs, uint(rhs.Uint64()))}
+		}
+		return &BigIntValue{Value: new(big.Int).Rsh(lhs, uint(rhs.Uint64()))}
+	case lexer.LESS:
+		return BOOLEAN(lhs.Cmp(rhs) < 0)
+	case lexer.LESS_EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) <= 0)
+	case lexer.GREATER:
+		return BOOLEAN(lhs.Cmp(rhs) > 0)
+	case lexer.GREATER_EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) >= 0)
+	case lexer.EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) == 0)
+	case lexer.NOT_EQUAL:
+		return BOOLEAN(lhs.Cmp(rhs) != 0)
+	}
+
+	i.errorHandler.Report(i.line, fmt.Sprintf("Unsupported bigint binary operator: '%s'", operator.Lexeme))
+	return NIL()
+}
+
+// Integer arithmetic wraps around on overflow, except `/` which always
+// divides exactly and so gives a float ---
+func (i *Interpreter) evaluateIntegerBinaryExpression(lhs int64, rhs int64, operator *lexer.Token) RuntimeValue {
+	switch operator.TokenType {
+	case lexer.PLUS:
+		return &IntegerValue{Value: lhs + rhs}
+	case lexer.MINUS:
+		return &IntegerValue{Value: lhs - rhs}
+	case lexer.STAR:
+		return &IntegerValue{Value: lhs * rhs}
+	case lexer.SLASH:
+		return i.evaluateNumericBinaryExpression(&NumberValue{Value: float64(lhs)}, &NumberValue{Value: float64(rhs)}, operator)
+	case lexer.TILDE_SLASH:
+		if rhs == 0 {
+			i.errorHandler.Report(i.line, "Division by zero")
+			return NIL()
+		}
+		return &IntegerValue{Value: floorDivide(lhs, rhs)}
+	case lexer.MODULO:
+		if rhs == 0 {
+			i.errorHandler.Report(i.line, "Modulo by zero")
+			return NIL()
+		}
+		return &IntegerValue{Value: floorModulo(lhs, rhs)}
+	case lexer.STAR_STAR:
+		if rhs < 0 { // 2 ** -1 is 0.5 ---
+			return &NumberValue{Value: math.Pow(float64(lhs), float64(rhs))}
+		}
+		return &IntegerValue{Value: integerPower(lhs, rhs)}
+	case lexer.AMPERSAND:
+		return &IntegerValue{Value: lhs & rhs}
+	case lexer.PIPE:
+		return &IntegerValue{Value: lhs | rhs}
+	case lexer.CARET:
+		return &IntegerValue{Value: lhs ^ rhs}
+	case lexer.SHIFT_LEFT, lexer.SHIFT_RIGHT:
+		if rhs < 0 {
+			i.errorHandler.ReportError(
+				"Interpreter-Binary",
+				fmt.Sprintf("Shift count cannot be negative (got %d)", rhs),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return NIL()
+		}
+		if operator.TokenType == lexer.SHIFT_LEFT {
+			return &IntegerValue{Value: lhs << uint64(rhs)}
+		}
+		return &IntegerValue{Value: lhs >> uint64(rhs)} // Keeps the sign ---
+	case lexer.LESS:
+		return BOOLEAN(lhs < rhs)
+	case lexer.LESS_EQUAL:
+		return BOOLEAN(lhs <= rhs)
+	case lexer.GREATER:
+		return BOOLEAN(lhs > rhs)
+	case lexer.GREATER_EQUAL:
+		return BOOLEAN(lhs >= rhs)
+	case lexer.EQUAL:
+		return BOOLEAN(lhs == rhs)
+	case lexer.NOT_EQUAL:
+		return BOOLEAN(lhs != rhs)
+	}
+
+	i.errorHandler.Report(i.line, fmt.Sprintf("Unsupported integer binary operator: '%s'", operator.Lexeme))
+	return NIL()
+}
+
+// Rounds towards negative infinity, unlike Go's `/` ---
+func floorDivide(lhs int64, rhs int64) int64 {
+	quotient := lhs / rhs
+	if lhs%rhs != 0 && (lhs < 0) != (rhs < 0) {
+		quotient--
+	}
+	return quotient
+}
+
+// Takes the sign of rhs, so `a ~/ b * b + a % b == a` always holds ---
+func floorModulo(lhs int64, rhs int64) int64 {
+	remainder := lhs % rhs
+	if remainder != 0 && (remainder < 0) != (rhs < 0) {
+		remainder += rhs
+	}
+	return remainder
+}
+
+// floorModulo for bigints and the unscaled values of decimals ---
+func floorRemainder(lhs *big.Int, rhs *big.Int) *big.Int {
+	remainder := new(big.Int).Rem(lhs, rhs)
+	if remainder.Sign() != 0 && remainder.Sign() != rhs.Sign() {
+		remainder.Add(remainder, rhs)
+	}
+	return remainder
+}
+
+func integerPower(base int64, exponent int64) int64 {
+	result := int64(1)
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result *= base
+		}
+		base *= base
+		exponent >>= 1
+	}
+	return result
+}
+
+func isBitwiseOperator(operator lexer.TokenType) bool {
+	switch operator {
+	case lexer.AMPERSAND, lexer.PIPE, lexer.CARET, lexer.SHIFT_LEFT, lexer.SHIFT_RIGHT:
+		return true
+	}
+	return false
+}
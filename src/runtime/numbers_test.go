@@ -0,0 +1,44 @@
+package runtime
+
+import "testing"
+
+// `%` rounds down like `~/`, so `a ~/ b * b + a % b == a` for every sign ---
+func TestFloorDivisionAndModulo(t *testing.T) {
+	runScriptTests(t, []scriptTest{
+		{
+			name:   "integers",
+			source: `var q = -7 ~/ 2; var r = -7 % 2; var s = 7 % -2; var ok = q * 2 + r == -7;`,
+			want:   map[string]string{"q": "-4", "r": "1", "s": "-1", "ok": "true"},
+		},
+		{
+			name:   "floats",
+			source: `var q = -7.5 ~/ 2.0; var r = -7.5 % 2.0; var ok = q * 2.0 + r == -7.5;`,
+			want:   map[string]string{"q": "-4", "r": "0.5", "ok": "true"},
+		},
+		{
+			name:   "bigints",
+			source: `var q = -7n ~/ 2n; var r = -7n % 2n; var s = 7n % -2n; var ok = q * 2n + r == -7n;`,
+			want:   map[string]string{"q": "-4", "r": "1", "s": "-1", "ok": "true"},
+		},
+		{
+			name:   "decimals",
+			source: `var a = decimal("-7.5"); var b = decimal("2"); var q = a ~/ b; var r = a % b; var ok = q * b + r == a;`,
+			want:   map[string]string{"q": "-4", "r": "0.5", "ok": "true"},
+		},
+		{
+			name:      "integer modulo by zero",
+			source:    `var r = 7 % 0;`,
+			wantError: "Modulo by zero",
+		},
+		{
+			name:      "bigint modulo by zero",
+			source:    `var r = 7n % 0n;`,
+			wantError: "Modulo by zero",
+		},
+		{
+			name:      "decimal modulo by zero",
+			source:    `var r = decimal("1.5") % decimal("0");`,
+			wantError: "Modulo by zero",
+		},
+	})
+}
@@ -0,0 +1,216 @@
+package runtime
+
+import (
+	"fmt"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Special methods objects and class instances can define to take part
+// in operators. `a != b` is answered by negating `__eq` ---
+var BINARY_OVERLOADS = map[lexer.TokenType]string{
+	lexer.PLUS:          "__add",
+	lexer.MINUS:         "__sub",
+	lexer.STAR:          "__mul",
+	lexer.SLASH:         "__div",
+	lexer.MODULO:        "__mod",
+	lexer.TILDE_SLASH:   "__idiv",
+	lexer.STAR_STAR:     "__pow",
+	lexer.AMPERSAND:     "__and",
+	lexer.PIPE:          "__or",
+	lexer.CARET:         "__xor",
+	lexer.SHIFT_LEFT:    "__shl",
+	lexer.SHIFT_RIGHT:   "__shr",
+	lexer.EQUAL:         "__eq",
+	lexer.NOT_EQUAL:     "__eq",
+	lexer.LESS:          "__lt",
+	lexer.LESS_EQUAL:    "__le",
+	lexer.GREATER:       "__gt",
+	lexer.GREATER_EQUAL: "__ge",
+}
+
+// `a < b` can also be answered by the right operand as `b > a` ---
+var MIRRORED_COMPARISONS = map[lexer.TokenType]lexer.TokenType{
+	lexer.EQUAL:         lexer.EQUAL,
+	lexer.NOT_EQUAL:     lexer.NOT_EQUAL,
+	lexer.LESS:          lexer.GREATER,
+	lexer.LESS_EQUAL:    lexer.GREATER_EQUAL,
+	lexer.GREATER:       lexer.LESS,
+	lexer.GREATER_EQUAL: lexer.LESS_EQUAL,
+}
+
+// Binary operator each compound assignment applies ---
+var COMPOUND_OPERATORS = map[lexer.TokenType]*lexer.Token{
+	lexer.PLUS_EQUALS:   {TokenType: lexer.PLUS, Lexeme: "+"},
+	lexer.MINUS_EQUALS:  {TokenType: lexer.MINUS, Lexeme: "-"},
+	lexer.STAR_EQUALS:   {TokenType: lexer.STAR, Lexeme: "*"},
+	lexer.SLASH_EQUALS:  {TokenType: lexer.SLASH, Lexeme: "/"},
+	lexer.MODULO_EQUALS: {TokenType: lexer.MODULO, Lexeme: "%"},
+}
+
+const (
+	NEGATE_OVERLOAD    = "__neg"
+	INVERT_OVERLOAD    = "__invert" // ~value ---
+	STRING_OVERLOAD    = "__str"
+	REFLECTED_OVERLOAD = "__r" // `2 * v` calls v.__rmul(2) ---
+)
+
+// Finds a special method on an instance or a callable property of an
+// object, inherited ones included, bound to the value it was found on ---
+func (i *Interpreter) findOverload(value RuntimeValue, name string) (RuntimeValue, bool) {
+	switch v := value.(type) {
+	case *InstanceValue:
+		if member, exists := i.getInstanceMember(v, name); exists && isCallable(member) {
+			return member, true
+		}
+	case *ObjectValue:
+		if property, exists := i.getObjectMember(v, name); exists && isCallable(property) {
+			return property, true
+		}
+	}
+	return nil, false
+}
+
+func isCallable(value RuntimeValue) bool {
+	switch value.(type) {
+	case *FunctionValue, *NativeFunctionValue:
+		return true
+	}
+	return false
+}
+
+// Dispatches a binary operator to the operands' special methods.
+// Reports false when neither operand overloads it ---
+func (i *Interpreter) callBinaryOverload(left RuntimeValue, right RuntimeValue, operator lexer.TokenType) (RuntimeValue, bool) {
+	name, exists := BINARY_OVERLOADS[operator]
+	if !exists {
+		return nil, false
+	}
+
+	method, found := i.findOverload(left, name)
+	argument := right
+
+	if !found {
+		if mirrored, isComparison := MIRRORED_COMPARISONS[operator]; isComparison {
+			method, found = i.findOverload(right, BINARY_OVERLOADS[mirrored])
+		} else {
+			method, found = i.findOverload(right, REFLECTED_OVERLOAD+name[2:])
+		}
+		argument = left
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	result := i.callValue(method, []RuntimeValue{argument}, i.globalEnv)
+	if operator == lexer.NOT_EQUAL {
+		return BOOLEAN(!isTruthy(result)), true
+	}
+	return result, true
+}
+
+func (i *Interpreter) callUnaryOverload(operand RuntimeValue, name string) (RuntimeValue, bool) {
+	method, found := i.findOverload(operand, name)
+	if !found {
+		return nil, false
+	}
+
+	return i.callValue(method, []RuntimeValue{}, i.globalEnv), true
+}
+
+// Applies a (non short-circuiting) binary operator to two values ---
+func (i *Interpreter) applyBinaryOperator(left RuntimeValue, right RuntimeValue, operator *lexer.Token) RuntimeValue {
+	// `inst == nil` never reaches `__eq`, so overloads don't have to
+	// guard against a nil argument ---
+	if left.Type() == NIL_VALUE || right.Type() == NIL_VALUE {
+		switch operator.TokenType {
+		case lexer.EQUAL:
+			return BOOLEAN(valuesEqual(left, right))
+		case lexer.NOT_EQUAL:
+			return BOOLEAN(!valuesEqual(left, right))
+		}
+	}
+
+	if result, handled := i.callBinaryOverload(left, right, operator.TokenType); handled {
+		return result
+	}
+
+	switch operator.TokenType {
+	case lexer.EQUAL:
+		return BOOLEAN(valuesEqual(left, right))
+	case lexer.NOT_EQUAL:
+		return BOOLEAN(!valuesEqual(left, right))
+	}
+
+	if isExactNumber(left) || isExactNumber(right) {
+		if result, handled := i.evaluateExactBinaryExpression(left, right, operator); handled {
+			return result
+		}
+	}
+
+	leftInt, leftIsInt := left.(*IntegerValue)
+	rightInt, rightIsInt := right.(*IntegerValue)
+
+	if leftIsInt && rightIsInt {
+		return i.evaluateIntegerBinaryExpression(leftInt.Value, rightInt.Value, operator)
+	}
+
+	leftNum, leftIsNum := toFloat(left)
+	rightNum, rightIsNum := toFloat(right)
+
+	if leftIsNum && rightIsNum {
+		return i.evaluateNumericBinaryExpression(&NumberValue{Value: leftNum}, &NumberValue{Value: rightNum}, operator)
+	}
+
+	leftStr, leftIsStr := left.(*StringValue)
+	rightStr, rightIsStr := right.(*StringValue)
+
+	if leftIsStr && rightIsStr {
+		return i.evaluateStringBinaryExpression(leftStr, rightStr, operator)
+	}
+
+	i.errorHandler.Report(i.line, fmt.Sprintf("Cannot perform '%s' binary operator with unsupported type (%s to %s)", operator.Lexeme, left.Type(), right.Type()))
+	return NIL()
+}
+
+// Strings are shown without quotes, everything else as String() renders
+// it, except that `__str` methods (also on nested values) decide themselves ---
+func (i *Interpreter) display(value RuntimeValue) string {
+	if str, ok := value.(*StringValue); ok {
+		return str.Value
+	}
+	return i.formatValue(value, 0, map[RuntimeValue]bool{})
+}
+
+func (i *Interpreter) formatValue(value RuntimeValue, depth int, visited map[RuntimeValue]bool) string {
+	if method, found := i.findOverload(value, STRING_OVERLOAD); found {
+		result := i.callValue(method, []RuntimeValue{}, i.globalEnv)
+		if str, ok := result.(*StringValue); ok {
+			return str.Value
+		}
+
+		i.errorHandler.ReportError(
+			"Interpreter-Operator",
+			fmt.Sprintf("'%s' must return a string, got '%s'", STRING_OVERLOAD, result.Type()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return ""
+	}
+
+	switch v := value.(type) {
+	case *ArrayValue:
+		return v.format(i.formatValue, visited)
+	case *ObjectValue:
+		return v.formatWithIndent(depth, i.formatValue, visited)
+	case *MapValue:
+		return v.format(i.formatValue, visited)
+	case *SetValue:
+		return v.format(i.formatValue, visited)
+	case *InstanceValue:
+		return v.Class.Name + " " + v.Fields.formatWithIndent(depth, i.formatValue, visited)
+	}
+	return value.String()
+}
@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"github.com/caelondev/lento/src/ast"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Returned through a chain once a `?.` link meets nil, so `a?.b.c()`
+// skips the rest of the chain instead of failing on `.c` ---
+type skippedChainValue struct {
+	NilValue
+}
+
+// Evaluates the object/caller of a chain link, keeping the skip marker
+// of an inner link instead of turning it into a plain nil ---
+func (i *Interpreter) evaluateChainTarget(expr ast.Expression, env Environment) RuntimeValue {
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	i.line = uint(expr.GetLine())
+
+	switch n := expr.(type) {
+	case *ast.MemberExpression:
+		return i.evaluateMemberExpression(n, env)
+	case *ast.IndexExpression:
+		return i.evaluateIndexExpression(n, env)
+	case *ast.CallExpression:
+		return i.evaluateCallExpression(n, env)
+	case *ast.SliceExpression:
+		return i.evaluateSliceExpression(n, env)
+	}
+
+	return i.EvaluateExpression(expr, env)
+}
+
+func skipsChain(target RuntimeValue, optional bool) bool {
+	if _, skipped := target.(*skippedChainValue); skipped {
+		return true
+	}
+	return optional && target.Type() == NIL_VALUE
+}
+
+// The whole chain evaluates to nil once it was skipped ---
+func endChain(value RuntimeValue) RuntimeValue {
+	if _, skipped := value.(*skippedChainValue); skipped {
+		return NIL()
+	}
+	return value
+}
+
+// target ??= value only evaluates and assigns value when target is nil.
+// The object and key of the target are evaluated once and reused ---
+func (i *Interpreter) evaluateNullishAssignment(expr *ast.AssignmentExpression, env Environment) RuntimeValue {
+	// Reading an absent property yields nil here instead of failing ---
+	switch assignee := expr.Assignee.(type) {
+	case *ast.SymbolExpression:
+		current := i.EvaluateExpression(assignee, env)
+		if i.errorHandler.HadError || current.Type() != NIL_VALUE {
+			return current
+		}
+		return i.assignToSymbol(assignee, i.EvaluateExpression(expr.Value, env), lexer.ASSIGNMENT, env)
+	case *ast.MemberExpression:
+		object := i.EvaluateExpression(assignee.Object, env)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		current := i.readMember(object, assignee.Property, true)
+		if i.errorHandler.HadError || current.Type() != NIL_VALUE {
+			return current
+		}
+		return i.assignToPropertyOf(object, assignee.Property, i.EvaluateExpression(expr.Value, env), lexer.ASSIGNMENT)
+	case *ast.IndexExpression:
+		target := i.EvaluateExpression(assignee.Expr, env)
+		index := i.EvaluateExpression(assignee.Index, env)
+		if i.errorHandler.HadError {
+			return NIL()
+		}
+		current := i.readIndex(target, index, true)
+		if i.errorHandler.HadError || current.Type() != NIL_VALUE {
+			return current
+		}
+		return i.assignToIndexOf(target, index, i.EvaluateExpression(expr.Value, env), lexer.ASSIGNMENT)
+	}
+
+	i.errorHandler.Report(i.line, "Invalid left-hand assignment")
+	return NIL()
+}
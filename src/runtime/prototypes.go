@@ -0,0 +1,141 @@
+package runtime
+
+import (
+	"fmt"
+
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+)
+
+// The global `Object`, holding the prototype functions ---
+func OBJECT_NAMESPACE() *ObjectValue {
+	return OBJECT([]ObjectPropertyValue{
+		{Key: "create", Value: NATIVE_FUNCTION("create", NATIVE_OBJECT_CREATE_FUNCTION)},
+		{Key: "getPrototypeOf", Value: NATIVE_FUNCTION("getPrototypeOf", NATIVE_OBJECT_GET_PROTOTYPE_FUNCTION)},
+		{Key: "setPrototypeOf", Value: NATIVE_FUNCTION("setPrototypeOf", NATIVE_OBJECT_SET_PROTOTYPE_FUNCTION)},
+		{Key: "hasOwn", Value: NATIVE_FUNCTION("hasOwn", NATIVE_OBJECT_HAS_OWN_FUNCTION)},
+	})
+}
+
+// Prototypes are objects, or nil for none ---
+func (i *Interpreter) expectPrototype(name string, value RuntimeValue) (*ObjectValue, bool) {
+	switch v := value.(type) {
+	case *ObjectValue:
+		return v, true
+	case *NilValue:
+		return nil, true
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Native-Function",
+		fmt.Sprintf("%s() expects the prototype to be an object or nil, got '%s'", name, value.Type()),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+	return nil, false
+}
+
+func (i *Interpreter) expectObject(name string, value RuntimeValue) (*ObjectValue, bool) {
+	if object, ok := value.(*ObjectValue); ok {
+		return object, true
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Native-Function",
+		fmt.Sprintf("%s() expects an object, got '%s'", name, value.Type()),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+	return nil, false
+}
+
+// Object.create(proto, properties?) makes an object that falls back to
+// proto for missing properties; the given properties are its own ---
+func NATIVE_OBJECT_CREATE_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) < 1 || len(args) > 2 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "create() expects one or two arguments", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	prototype, ok := i.expectPrototype("create", args[0])
+	if !ok {
+		return NIL()
+	}
+
+	object := OBJECT(nil)
+	object.Prototype = prototype
+
+	if len(args) == 2 {
+		properties, ok := i.expectObject("create", args[1])
+		if !ok {
+			return NIL()
+		}
+		for _, property := range properties.properties() {
+			object.Set(property.Key, property.Value)
+		}
+	}
+	return object
+}
+
+func NATIVE_OBJECT_GET_PROTOTYPE_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 1 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "getPrototypeOf() expects exactly one argument", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	object, ok := i.expectObject("getPrototypeOf", args[0])
+	if !ok {
+		return NIL()
+	}
+
+	prototype := object.GetPrototype()
+	if prototype == nil {
+		return NIL()
+	}
+	return prototype
+}
+
+// Object.setPrototypeOf(obj, proto) relinks obj, refusing links that
+// would make the chain loop back to obj ---
+func NATIVE_OBJECT_SET_PROTOTYPE_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 2 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "setPrototypeOf() expects exactly two arguments", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	object, ok := i.expectObject("setPrototypeOf", args[0])
+	if !ok {
+		return NIL()
+	}
+	prototype, ok := i.expectPrototype("setPrototypeOf", args[1])
+	if !ok {
+		return NIL()
+	}
+
+	if prototype == object || (prototype != nil && prototype.InheritsFrom(object)) {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "setPrototypeOf() cannot create a cyclic prototype chain", i.line, errorhandler.InvalidArgumentError)
+		return NIL()
+	}
+
+	object.SetPrototype(prototype)
+	return object
+}
+
+// Object.hasOwn(obj, key) ignores inherited properties ---
+func NATIVE_OBJECT_HAS_OWN_FUNCTION(args []RuntimeValue, env Environment, i *Interpreter) RuntimeValue {
+	if len(args) != 2 {
+		i.errorHandler.ReportError("Interpreter-Native-Function", "hasOwn() expects exactly two arguments", i.line, errorhandler.ArgumentLengthError)
+		return NIL()
+	}
+
+	object, ok := i.expectObject("hasOwn", args[0])
+	if !ok {
+		return NIL()
+	}
+	key, ok := i.expectString("hasOwn", args[1])
+	if !ok {
+		return NIL()
+	}
+
+	_, exists := object.Get(key)
+	return BOOLEAN(exists)
+}
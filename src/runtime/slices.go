@@ -0,0 +1,215 @@
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
+	"github.com/caelondev/lento/src/lexer"
+)
+
+// Bounds of a slice once negative indices and defaults are resolved ---
+type sliceBounds struct {
+	start int
+	end   int
+	step  int
+}
+
+// Positions the slice visits, in order ---
+func (b sliceBounds) indices() []int {
+	var indices []int
+	for idx := b.start; (b.step > 0 && idx < b.end) || (b.step < 0 && idx > b.end); idx += b.step {
+		indices = append(indices, idx)
+	}
+	return indices
+}
+
+func (i *Interpreter) evaluateSliceExpression(expr *ast.SliceExpression, env Environment) RuntimeValue {
+	target := i.evaluateChainTarget(expr.Expr, env)
+	if skipsChain(target, expr.Optional) {
+		return &skippedChainValue{}
+	}
+
+	switch v := target.(type) {
+	case *ArrayValue:
+		source := v.snapshot()
+		bounds, ok := i.resolveSlice(expr, len(source), env)
+		if !ok {
+			return NIL()
+		}
+
+		elements := make([]RuntimeValue, 0)
+		for _, idx := range bounds.indices() {
+			elements = append(elements, source[idx])
+		}
+		return ARRAY(elements)
+
+	case *StringValue:
+		chars := []rune(v.Value)
+		bounds, ok := i.resolveSlice(expr, len(chars), env)
+		if !ok {
+			return NIL()
+		}
+
+		sliced := make([]rune, 0)
+		for _, idx := range bounds.indices() {
+			sliced = append(sliced, chars[idx])
+		}
+		return &StringValue{Value: string(sliced)}
+	}
+
+	if expr.Optional {
+		return NIL()
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Slice",
+		fmt.Sprintf("Cannot slice type '%s'", target.Type()),
+		i.line,
+		errorhandler.InvalidArgumentError,
+	)
+	return NIL()
+}
+
+// arr[start:end] = values replaces that part, growing or shrinking the
+// array; with a step the number of values must match ---
+func (i *Interpreter) assignToSlice(assignee *ast.SliceExpression, value RuntimeValue, operator lexer.TokenType, env Environment) RuntimeValue {
+	if operator != lexer.ASSIGNMENT {
+		i.errorHandler.ReportError(
+			"Interpreter-Slice",
+			"Compound assignment is not supported on slices",
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return NIL()
+	}
+
+	target := i.EvaluateExpression(assignee.Expr, env)
+	array, ok := target.(*ArrayValue)
+	if !ok {
+		i.errorHandler.ReportError(
+			"Interpreter-Slice",
+			fmt.Sprintf("Cannot assign to a slice of type '%s'", target.Type()),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return NIL()
+	}
+
+	bounds, ok := i.resolveSlice(assignee, array.length(), env)
+	if !ok {
+		return NIL()
+	}
+
+	replacement := i.collectValues(value)
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	if bounds.step == 1 {
+		array.mutate(func(current []RuntimeValue) []RuntimeValue {
+			// Another task may have resized the array since the bounds were resolved ---
+			start := min(bounds.start, len(current))
+			end := min(max(bounds.end, start), len(current))
+
+			elements := make([]RuntimeValue, 0, len(current)-(end-start)+len(replacement))
+			elements = append(elements, current[:start]...)
+			elements = append(elements, replacement...)
+			elements = append(elements, current[end:]...)
+			return elements
+		})
+		return value
+	}
+
+	indices := bounds.indices()
+	if len(indices) != len(replacement) {
+		i.errorHandler.ReportError(
+			"Interpreter-Slice",
+			fmt.Sprintf("Cannot assign %d values to a slice of %d elements", len(replacement), len(indices)),
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return NIL()
+	}
+
+	array.mutate(func(current []RuntimeValue) []RuntimeValue {
+		for position, idx := range indices {
+			if idx < len(current) {
+				current[idx] = replacement[position]
+			}
+		}
+		return current
+	})
+	return value
+}
+
+// Evaluates the slice's bounds for a sequence of the given length.
+// Negative indices count from the end and out of range ones are clamped ---
+func (i *Interpreter) resolveSlice(expr *ast.SliceExpression, length int, env Environment) (sliceBounds, bool) {
+	var parts [3]*int
+	for idx, boundExpr := range []ast.Expression{expr.Start, expr.End, expr.Step} {
+		if boundExpr == nil {
+			continue
+		}
+
+		bound := i.EvaluateExpression(boundExpr, env)
+		if _, isNil := bound.(*NilValue); isNil {
+			continue
+		}
+
+		number, ok := toInteger(bound)
+		if !ok {
+			i.errorHandler.ReportError(
+				"Interpreter-Slice",
+				fmt.Sprintf("Slice bounds must be integers, got '%s'", bound.Type()),
+				i.line,
+				errorhandler.InvalidArgumentError,
+			)
+			return sliceBounds{}, false
+		}
+
+		value := int(number)
+		parts[idx] = &value
+	}
+
+	bounds := sliceBounds{step: 1}
+	if parts[2] != nil {
+		bounds.step = *parts[2]
+	}
+
+	if bounds.step == 0 {
+		i.errorHandler.ReportError(
+			"Interpreter-Slice",
+			"Slice step cannot be zero",
+			i.line,
+			errorhandler.InvalidArgumentError,
+		)
+		return sliceBounds{}, false
+	}
+
+	// Going backwards, the slice starts at the last element and can run
+	// down to just before the first ---
+	lower, upper := 0, length
+	if bounds.step < 0 {
+		lower, upper = -1, length-1
+	}
+
+	clamp := func(bound *int, fallback int) int {
+		if bound == nil {
+			return fallback
+		}
+
+		value := *bound
+		if value < 0 {
+			value += length
+		}
+		return min(max(value, lower), upper)
+	}
+
+	if bounds.step > 0 {
+		bounds.start, bounds.end = clamp(parts[0], lower), clamp(parts[1], upper)
+	} else {
+		bounds.start, bounds.end = clamp(parts[0], upper), clamp(parts[1], lower)
+	}
+	return bounds, true
+}
@@ -24,17 +24,33 @@ func (i *Interpreter) EvaluateStatement(stmt ast.Statement, env Environment) Run
 	case *ast.IfStatement:
 		return i.evaluateIfStatement(n, env)
 	case *ast.FunctionDeclarationStatement:
-		return evaluateFunctionDeclaration(n, env)
+		return i.evaluateFunctionDeclaration(n, env)
+	case *ast.ClassDeclarationStatement:
+		return i.evaluateClassDeclaration(n, env)
+	case *ast.EnumDeclarationStatement:
+		return i.evaluateEnumDeclaration(n, env)
 	case *ast.WhileLoopStatement:
 		return i.evaluateWhileLoopStatement(n, env)
 	case *ast.ForStatement:
 		return i.evaluateForStatement(n, env)
+	case *ast.ForOfStatement:
+		return i.evaluateForOfStatement(n, env)
 	case *ast.ReturnStatement:
 		return i.evaluateReturnStatement(n, env)
 	case *ast.BreakStatement:
 		return i.evaluateBreakStatement(n, env)
 	case *ast.ContinueStatement:
 		return i.evaluateContinueStatement(n, env)
+	case *ast.TryStatement:
+		return i.evaluateTryStatement(n, env)
+	case *ast.ThrowStatement:
+		return i.evaluateThrowStatement(n, env)
+	case *ast.ImportStatement:
+		return i.evaluateImportStatement(n, env)
+	case *ast.SelectStatement:
+		return i.evaluateSelectStatement(n, env)
+	case *ast.ExportStatement:
+		return i.evaluateExportStatement(n, env)
 
 
 	default:
@@ -75,6 +91,11 @@ func evaluateVariableDeclarationStatement(decl *ast.VariableDeclarationStatement
 		value = i.EvaluateExpression(decl.Value, env)
 	}
 
+	if decl.Pattern != nil {
+		i.declarePattern(decl.Pattern, value, env, decl.IsConstant)
+		return value
+	}
+
 	env.DeclareVariable(i.line, decl.Identifier, value, decl.IsConstant, false)
 	return value
 }
@@ -92,13 +113,15 @@ func (i *Interpreter) evaluateIfStatement(stmt *ast.IfStatement, env Environment
 }
 
 
-func evaluateFunctionDeclaration(stmt *ast.FunctionDeclarationStatement, env Environment) RuntimeValue {
+func (i *Interpreter) evaluateFunctionDeclaration(stmt *ast.FunctionDeclarationStatement, env Environment) RuntimeValue {
 	// Capture the current environment (closure)
 	fn := &FunctionValue{
 		Name:        stmt.Name,
 		Parameters:  stmt.Parameters,
 		Body:        stmt.Body,
+		IsGenerator: stmt.IsGenerator,
 		Environment: env,
+		File:        i.errorHandler.File,
 	}
 
 	env.DeclareVariable(stmt.Line, stmt.Name, fn, true, false)
@@ -170,6 +193,59 @@ func (i *Interpreter) evaluateForStatement(stmt *ast.ForStatement, env Environme
 	return NIL()
 }
 
+func (i *Interpreter) evaluateForOfStatement(stmt *ast.ForOfStatement, env Environment) RuntimeValue {
+	iterable := i.resolveIterable(i.EvaluateExpression(stmt.Iterable, env))
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	wasInLoop := i.isInLoop
+	i.isInLoop = true
+
+	defer func() { i.isInLoop = wasInLoop }()
+
+	// A single loop variable receives the keys of an object but the values
+	// of everything else, `__iter` replacements included ---
+	_, bindKey := iterable.(*ObjectValue)
+
+	var outcome RuntimeValue = NIL()
+	i.iterateResolved(iterable, func(key, value RuntimeValue) bool {
+		iterationScope := NewEnvironment(env, i.errorHandler) // Fresh binding per iteration for closures ---
+
+		if len(stmt.Identifiers) == 1 {
+			bound := value
+			if bindKey {
+				bound = key
+			}
+			iterationScope.DeclareVariable(stmt.Line, stmt.Identifiers[0], bound, stmt.IsConstant, false)
+		} else {
+			iterationScope.DeclareVariable(stmt.Line, stmt.Identifiers[0], key, stmt.IsConstant, false)
+			iterationScope.DeclareVariable(stmt.Line, stmt.Identifiers[1], value, stmt.IsConstant, false)
+		}
+
+		result := i.EvaluateStatement(stmt.Body, iterationScope)
+		if i.errorHandler.HadError {
+			return false
+		}
+
+		if control, ok := result.(*ControlFlowValue); ok {
+			switch control.FlowType {
+			case FLOW_BREAK:
+				return false
+			case FLOW_CONTINUE:
+				// Continue
+			case FLOW_RETURN:
+				outcome = control // Propagate return up
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return outcome
+}
+
 func (i *Interpreter) evaluateReturnStatement(stmt *ast.ReturnStatement, env Environment) RuntimeValue {
 	var value RuntimeValue = NIL()
 
@@ -214,3 +290,95 @@ func (i *Interpreter) evaluateContinueStatement(stmt *ast.ContinueStatement, env
 
 	return CONTINUE()
 }
+
+func (i *Interpreter) evaluateThrowStatement(stmt *ast.ThrowStatement, env Environment) RuntimeValue {
+	value := i.EvaluateExpression(stmt.Value, env)
+	if i.errorHandler.HadError {
+		return NIL()
+	}
+
+	i.thrown = value
+
+	// Rethrowing a caught runtime error keeps its original code and line ---
+	if errValue, ok := value.(*ErrorValue); ok {
+		i.errorHandler.ReportError("Interpreter-Throw", errValue.Message, errValue.Line, errorhandler.ErrorType(errValue.Code))
+		return NIL()
+	}
+
+	i.errorHandler.ReportError(
+		"Interpreter-Throw",
+		fmt.Sprintf("Uncaught %s", i.display(value)),
+		i.line,
+		errorhandler.ThrowError,
+	)
+	return NIL()
+}
+
+func (i *Interpreter) evaluateTryStatement(stmt *ast.TryStatement, env Environment) RuntimeValue {
+	result := i.evaluateGuarded(stmt.Body, env)
+	pending, pendingValue := i.recoverError()
+
+	// Closing a generator unwinds through finally blocks only ---
+	if pending != nil && stmt.Catch != nil && pending.Code != errorhandler.GeneratorClosedError {
+		catchScope := NewEnvironment(env, i.errorHandler)
+		if stmt.CatchParam != "" {
+			catchScope.DeclareVariable(stmt.Line, stmt.CatchParam, pendingValue, false, false)
+		}
+
+		// Errors raised by the catch block itself still have to wait for finally ---
+		if stmt.Finally != nil {
+			result = i.evaluateGuarded(stmt.Catch, catchScope)
+			pending, pendingValue = i.recoverError()
+		} else {
+			return i.EvaluateStatement(stmt.Catch, catchScope)
+		}
+	}
+
+	if stmt.Finally != nil {
+		finalResult := i.EvaluateStatement(stmt.Finally, env)
+		if i.errorHandler.HadError {
+			return NIL() // An error inside finally replaces the pending one ---
+		}
+
+		if _, ok := finalResult.(*ControlFlowValue); ok {
+			return finalResult
+		}
+	}
+
+	if pending != nil {
+		i.thrown = pendingValue
+		i.errorHandler.Raise(pending)
+		return NIL()
+	}
+
+	return result
+}
+
+// Evaluates a statement while holding back any error it raises ---
+func (i *Interpreter) evaluateGuarded(stmt ast.Statement, env Environment) RuntimeValue {
+	i.errorHandler.BeginCatch()
+	defer i.errorHandler.EndCatch()
+
+	return i.EvaluateStatement(stmt, env)
+}
+
+// Clears a held-back error and converts it into the value a catch block receives ---
+func (i *Interpreter) recoverError() (*errorhandler.Error, RuntimeValue) {
+	if !i.errorHandler.HadError {
+		return nil, nil
+	}
+
+	err := i.errorHandler.Recover()
+	thrown := i.thrown
+	i.thrown = nil
+
+	if thrown != nil {
+		return err, thrown
+	}
+
+	return err, &ErrorValue{
+		Code:    string(err.Code),
+		Message: err.Message,
+		Line:    err.Line,
+	}
+}
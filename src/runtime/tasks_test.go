@@ -0,0 +1,60 @@
+package runtime
+
+import "testing"
+
+// Run with `go test -race` to also catch unsynchronised Go accesses ---
+func TestTasksDoNotLoseUpdates(t *testing.T) {
+	runScriptTests(t, []scriptTest{
+		{
+			name: "compound assignment to a global",
+			source: `
+				var counter = 0;
+				fn work() { for (var j = 0; j < 2000; j += 1) { counter += 1; counter++; } }
+				wait([spawn work(), spawn work(), spawn work(), spawn work()]);
+			`,
+			want: map[string]string{"counter": "16000"},
+		},
+		{
+			name: "push onto a shared array",
+			source: `
+				var shared = [];
+				fn work() { for (var j = 0; j < 2000; j += 1) shared.push(j); }
+				wait([spawn work(), spawn work(), spawn work(), spawn work()]);
+				var count = len(shared);
+			`,
+			want: map[string]string{"count": "8000"},
+		},
+		{
+			name: "compound assignment to members",
+			source: `
+				var obj = { n: 0 };
+				var arr = [0];
+				var m = #{ "k": 0 };
+				fn work() {
+					for (var j = 0; j < 2000; j += 1) {
+						obj.n += 1;
+						arr[0] += 1;
+						m["k"] += 1;
+					}
+				}
+				wait([spawn work(), spawn work(), spawn work(), spawn work()]);
+				var n = obj.n;
+				var first = arr[0];
+				var k = m["k"];
+			`,
+			want: map[string]string{"n": "8000", "first": "8000", "k": "8000"},
+		},
+		{
+			name: "readers alongside writers",
+			source: `
+				var shared = [];
+				var obj = {};
+				fn write() { for (var j = 0; j < 1000; j += 1) { shared.push(j); obj[str(j)] = j; } }
+				fn read() { var seen = 0; for (var j = 0; j < 1000; j += 1) seen = len(shared) + len(obj.keys()); return seen; }
+				wait([spawn write(), spawn read(), spawn write(), spawn read()]);
+				var count = len(shared);
+			`,
+			want: map[string]string{"count": "2000"},
+		},
+	})
+}
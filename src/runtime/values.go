@@ -2,8 +2,14 @@ package runtime
 
 import (
 	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/caelondev/lento/src/ast"
+	errorhandler "github.com/caelondev/lento/src/error-handler"
 )
 
 type ValueTypes string
@@ -12,11 +18,26 @@ const (
 	BOOLEAN_VALUE ValueTypes = "boolean"
 	NIL_VALUE    ValueTypes = "nil"
 	NUMBER_VALUE ValueTypes = "number"
+	INTEGER_VALUE ValueTypes = "integer"
+	BIGINT_VALUE ValueTypes = "bigint"
+	DECIMAL_VALUE ValueTypes = "decimal"
 	STRING_VALUE ValueTypes = "string"
 	ARRAY_VALUE ValueTypes = "array"
 	OBJECT_VALUE ValueTypes = "object"
 	FUNCTION_VALUE        ValueTypes = "function"
 	NATIVE_FUNCTION_VALUE ValueTypes = "native_function"
+	RANGE_VALUE           ValueTypes = "range"
+	CLASS_VALUE           ValueTypes = "class"
+	INSTANCE_VALUE        ValueTypes = "instance"
+	ERROR_VALUE           ValueTypes = "error"
+	MODULE_VALUE          ValueTypes = "module"
+	ENUM_VALUE            ValueTypes = "enum"
+	ENUM_VARIANT_VALUE    ValueTypes = "enum_variant"
+	GENERATOR_VALUE       ValueTypes = "generator"
+	TASK_VALUE            ValueTypes = "task"
+	CHANNEL_VALUE         ValueTypes = "channel"
+	MAP_VALUE             ValueTypes = "map"
+	SET_VALUE             ValueTypes = "set"
 )
 
 const (
@@ -25,6 +46,8 @@ const (
 	FLOW_RETURN = "return"
 )
 
+const ANONYMOUS_FUNCTION_NAME = "<anonymous>"
+
 type RuntimeValue interface {
 	Type() ValueTypes
 	String() string
@@ -32,28 +55,107 @@ type RuntimeValue interface {
 
 type ArrayValue struct {
 	Elements []RuntimeValue
+
+	mutex sync.RWMutex // Spawned tasks can share an array ---
 }
 
 func (a *ArrayValue) Type() ValueTypes {
 	return ARRAY_VALUE
 }
 
+// Copy of the elements, safe to range over while other tasks change the array ---
+func (a *ArrayValue) snapshot() []RuntimeValue {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return append([]RuntimeValue(nil), a.Elements...)
+}
+
+func (a *ArrayValue) length() int {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return len(a.Elements)
+}
+
+func (a *ArrayValue) at(idx int) (RuntimeValue, bool) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	if idx < 0 || idx >= len(a.Elements) {
+		return nil, false
+	}
+	return a.Elements[idx], true
+}
+
+// Replaces the elements with what change returns, holding the lock.
+// change must not call back into the interpreter ---
+func (a *ArrayValue) mutate(change func(elements []RuntimeValue) []RuntimeValue) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.Elements = change(a.Elements)
+}
+
+// Stores value at idx only while it still holds expected, so a compound
+// assignment computed from expected doesn't overwrite another task's ---
+func (a *ArrayValue) swap(idx int, expected RuntimeValue, value RuntimeValue) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if idx < 0 || idx >= len(a.Elements) || a.Elements[idx] != expected {
+		return false
+	}
+	a.Elements[idx] = value
+	return true
+}
+
 func (a *ArrayValue) String() string {
-	if len(a.Elements) == 0 {
+	return a.format(formatValue, map[RuntimeValue]bool{})
+}
+
+func (a *ArrayValue) format(format valueFormatter, visited map[RuntimeValue]bool) string {
+	elements := a.snapshot()
+	if len(elements) == 0 {
 		return "[]"
 	}
+	if visited[a] {
+		return "[...]"
+	}
+	visited[a] = true
+	defer delete(visited, a)
 	
 	result := "["
-	for i, elem := range a.Elements {
+	for i, elem := range elements {
 		if i > 0 {
 			result += ", "
 		}
-		result += elem.String()
+		result += format(elem, 0, visited)
 	}
 	result += "]"
 	return result
 }
 
+// Renders a value nested at the given depth inside an array or object.
+// The interpreter passes its own formatter to honour `__str` methods.
+// visited holds the containers being printed, so one that contains
+// itself prints as a placeholder instead of recursing forever ---
+type valueFormatter func(value RuntimeValue, depth int, visited map[RuntimeValue]bool) string
+
+func formatValue(value RuntimeValue, depth int, visited map[RuntimeValue]bool) string {
+	switch v := value.(type) {
+	case *ArrayValue:
+		return v.format(formatValue, visited)
+	case *ObjectValue: // Nested objects get proper indentation ---
+		return v.formatWithIndent(depth, formatValue, visited)
+	case *MapValue:
+		return v.format(formatValue, visited)
+	case *SetValue:
+		return v.format(formatValue, visited)
+	}
+	return value.String()
+}
+
 type ObjectPropertyValue struct {
 	Key string
 	Value RuntimeValue
@@ -61,20 +163,136 @@ type ObjectPropertyValue struct {
 
 type ObjectValue struct {
 	Properties []ObjectPropertyValue
+	Prototype  *ObjectValue // Consulted for properties the object lacks ---
+
+	mutex sync.RWMutex // Spawned tasks can share an object ---
 }
 
 func (n *ObjectValue) Type() ValueTypes {
 	return OBJECT_VALUE
 }
 
+func (n *ObjectValue) Get(key string) (RuntimeValue, bool) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	for _, prop := range n.Properties {
+		if prop.Key == key {
+			return prop.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Own property, or else the nearest one up the prototype chain ---
+func (n *ObjectValue) Lookup(key string) (RuntimeValue, bool) {
+	for current := n; current != nil; current = current.GetPrototype() {
+		if value, exists := current.Get(key); exists {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func (n *ObjectValue) GetPrototype() *ObjectValue {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	return n.Prototype
+}
+
+func (n *ObjectValue) SetPrototype(prototype *ObjectValue) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.Prototype = prototype
+}
+
+// Reports whether ancestor is somewhere up the prototype chain ---
+func (n *ObjectValue) InheritsFrom(ancestor *ObjectValue) bool {
+	for current := n.GetPrototype(); current != nil; current = current.GetPrototype() {
+		if current == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Overwrites an existing property or appends a new one ---
+func (n *ObjectValue) Set(key string, value RuntimeValue) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.setLocked(key, value)
+}
+
+func (n *ObjectValue) setLocked(key string, value RuntimeValue) {
+	for idx, prop := range n.Properties {
+		if prop.Key == key {
+			n.Properties[idx].Value = value
+			return
+		}
+	}
+	n.Properties = append(n.Properties, ObjectPropertyValue{Key: key, Value: value})
+}
+
+// Stores value under key only while the own property still holds
+// expected, or is still missing when expected is nil ---
+func (n *ObjectValue) swap(key string, expected RuntimeValue, value RuntimeValue) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	current, exists := RuntimeValue(nil), false
+	for _, prop := range n.Properties {
+		if prop.Key == key {
+			current, exists = prop.Value, true
+			break
+		}
+	}
+
+	if (expected == nil && exists) || (expected != nil && current != expected) {
+		return false
+	}
+	n.setLocked(key, value)
+	return true
+}
+
+// Removes an own property and returns its value ---
+func (n *ObjectValue) Delete(key string) (RuntimeValue, bool) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for idx, prop := range n.Properties {
+		if prop.Key == key {
+			n.Properties = append(n.Properties[:idx], n.Properties[idx+1:]...)
+			return prop.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Copy of the own properties, safe to range over while other tasks change the object ---
+func (n *ObjectValue) properties() []ObjectPropertyValue {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	return append([]ObjectPropertyValue(nil), n.Properties...)
+}
+
 func (n *ObjectValue) String() string {
-	return n.stringWithIndent(0)
+	return n.formatWithIndent(0, formatValue, map[RuntimeValue]bool{})
 }
 
-func (n *ObjectValue) stringWithIndent(depth int) string {
-	if len(n.Properties) == 0 {
+func (n *ObjectValue) formatWithIndent(depth int, format valueFormatter, visited map[RuntimeValue]bool) string {
+	properties := n.properties()
+	if len(properties) == 0 {
 		return "{}"
 	}
+	if visited[n] {
+		return "{...}"
+	}
+	visited[n] = true
+	defer delete(visited, n)
 
 	indent := ""
 	for range depth {
@@ -83,16 +301,9 @@ func (n *ObjectValue) stringWithIndent(depth int) string {
 	nextIndent := indent + "  "
 
 	result := "{\n"
-	for _, prop := range n.Properties {
+	for _, prop := range properties {
 		result += nextIndent + prop.Key + ": "
-		
-		// Handle nested objects with proper indentation
-		if obj, ok := prop.Value.(*ObjectValue); ok {
-			result += obj.stringWithIndent(depth + 1)
-		} else {
-			result += prop.Value.String()
-		}
-		
+		result += format(prop.Value, depth+1, visited)
 		result += ",\n"
 	}
 	result += indent + "}"
@@ -100,6 +311,204 @@ func (n *ObjectValue) stringWithIndent(depth int) string {
 }
 
 
+type ClassValue struct {
+	Name       string
+	Superclass *ClassValue
+	Methods    map[string]*FunctionValue
+	File       string // Script the class was declared in ---
+}
+
+func (c *ClassValue) Type() ValueTypes {
+	return CLASS_VALUE
+}
+
+func (c *ClassValue) String() string {
+	return fmt.Sprintf("[ class '%s' ]", c.Name)
+}
+
+// Looks the method up on the class first, then along its superclasses ---
+func (c *ClassValue) FindMethod(name string) (*FunctionValue, bool) {
+	for class := c; class != nil; class = class.Superclass {
+		if method, exists := class.Methods[name]; exists {
+			return method, true
+		}
+	}
+	return nil, false
+}
+
+type InstanceValue struct {
+	Class  *ClassValue
+	Fields *ObjectValue
+}
+
+func (n *InstanceValue) Type() ValueTypes {
+	return INSTANCE_VALUE
+}
+
+func (n *InstanceValue) String() string {
+	return n.Class.Name + " " + n.Fields.String()
+}
+
+type EnumValue struct {
+	Name     string
+	Variants []*EnumVariantValue
+}
+
+func (e *EnumValue) Type() ValueTypes {
+	return ENUM_VALUE
+}
+
+func (e *EnumValue) String() string {
+	return fmt.Sprintf("[ enum '%s' ]", e.Name)
+}
+
+func (e *EnumValue) FindVariant(name string) (*EnumVariantValue, bool) {
+	for _, variant := range e.Variants {
+		if variant.Name == name {
+			return variant, true
+		}
+	}
+	return nil, false
+}
+
+// A variant declared with fields is a constructor until it is called,
+// calling it returns a copy holding the associated Values ---
+type EnumVariantValue struct {
+	Enum    *EnumValue
+	Name    string
+	Ordinal int
+	Fields  []string
+	Values  []RuntimeValue
+}
+
+func (v *EnumVariantValue) Type() ValueTypes {
+	return ENUM_VARIANT_VALUE
+}
+
+func (v *EnumVariantValue) String() string {
+	name := v.Enum.Name + "." + v.Name
+	if v.IsConstructor() {
+		return fmt.Sprintf("[ variant constructor '%s' ]", name)
+	}
+	if len(v.Values) == 0 {
+		return name
+	}
+
+	result := name + "("
+	for idx, value := range v.Values {
+		if idx > 0 {
+			result += ", "
+		}
+		result += value.String()
+	}
+	return result + ")"
+}
+
+func (v *EnumVariantValue) IsConstructor() bool {
+	return len(v.Fields) > 0 && v.Values == nil
+}
+
+// Runs a generator function's body on its own goroutine, handing
+// control back and forth so only one side is ever running ---
+type GeneratorValue struct {
+	Name string
+	Done bool
+
+	body        ast.Statement
+	scope       Environment
+	interpreter *Interpreter
+	started     bool
+	running     bool
+	closing     bool
+	catchDepth  int    // try statements open inside the suspended body ---
+	file        string // Script the generator function was defined in ---
+
+	resume chan RuntimeValue
+	steps  chan generatorStep
+}
+
+type generatorStep struct {
+	value RuntimeValue
+	done  bool
+}
+
+func (g *GeneratorValue) Type() ValueTypes {
+	return GENERATOR_VALUE
+}
+
+func (g *GeneratorValue) String() string {
+	return fmt.Sprintf("[ generator '%s' ]", g.Name)
+}
+
+// Handle of a function running on its own goroutine. Result, or the
+// error that stopped it, is only read after finished is closed ---
+type TaskValue struct {
+	Name string
+
+	finished chan struct{}
+	result   RuntimeValue
+	err      *errorhandler.Error
+	thrown   RuntimeValue
+}
+
+func (t *TaskValue) Type() ValueTypes {
+	return TASK_VALUE
+}
+
+func (t *TaskValue) String() string {
+	return fmt.Sprintf("[ task '%s' ]", t.Name)
+}
+
+func (t *TaskValue) IsDone() bool {
+	select {
+	case <-t.finished:
+		return true
+	default:
+		return false
+	}
+}
+
+type ChannelValue struct {
+	Channel  chan RuntimeValue
+	Capacity int
+	closed   atomic.Bool
+}
+
+func (c *ChannelValue) Type() ValueTypes {
+	return CHANNEL_VALUE
+}
+
+func (c *ChannelValue) String() string {
+	return fmt.Sprintf("[ channel (capacity %d) ]", c.Capacity)
+}
+
+type ErrorValue struct {
+	Code    string
+	Message string
+	Line    uint
+}
+
+func (e *ErrorValue) Type() ValueTypes {
+	return ERROR_VALUE
+}
+
+func (e *ErrorValue) String() string {
+	return fmt.Sprintf("[ error %s on line %d: %s ]", e.Code, e.Line, e.Message)
+}
+
+type ModuleValue struct {
+	Path    string
+	Exports *ObjectValue
+}
+
+func (m *ModuleValue) Type() ValueTypes {
+	return MODULE_VALUE
+}
+
+func (m *ModuleValue) String() string {
+	return fmt.Sprintf("[ module '%s' ]", m.Path)
+}
+
 type NilValue struct{}
 
 func (n *NilValue) Type() ValueTypes {
@@ -148,11 +557,67 @@ func (n *NumberValue) String() string {
 	return fmt.Sprintf("%v", n.Value)
 }
 
+// 64-bit integers, wrapping around on overflow ---
+type IntegerValue struct {
+	Value int64
+}
+
+func (n *IntegerValue) Type() ValueTypes {
+	return INTEGER_VALUE
+}
+
+func (n *IntegerValue) String() string {
+	return strconv.FormatInt(n.Value, 10)
+}
+
+// Arbitrary-precision integers. Value is never modified in place ---
+type BigIntValue struct {
+	Value *big.Int
+}
+
+func (n *BigIntValue) Type() ValueTypes {
+	return BIGINT_VALUE
+}
+
+func (n *BigIntValue) String() string {
+	return n.Value.String()
+}
+
+// Exact decimals worth Unscaled * 10^-Scale, so d"19.99" is 1999 at scale 2 ---
+type DecimalValue struct {
+	Unscaled *big.Int
+	Scale    int
+}
+
+func (n *DecimalValue) Type() ValueTypes {
+	return DECIMAL_VALUE
+}
+
+func (n *DecimalValue) String() string {
+	digits := new(big.Int).Abs(n.Unscaled).String()
+	sign := ""
+	if n.Unscaled.Sign() < 0 {
+		sign = "-"
+	}
+
+	if n.Scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= n.Scale {
+		digits = strings.Repeat("0", n.Scale-len(digits)+1) + digits
+	}
+
+	point := len(digits) - n.Scale
+	return sign + digits[:point] + "." + digits[point:]
+}
+
 type FunctionValue struct {
 	Name string
-	Parameters []string
+	Parameters []ast.Pattern
 	Body ast.Statement
 	Environment Environment
+	IsGenerator bool
+	File string // Script the function was defined in, named in its errors ---
 }
 
 func (n *FunctionValue) Type() ValueTypes {
@@ -163,6 +628,81 @@ func (n *FunctionValue) String() string {
 	return fmt.Sprintf("[ function '%s' ]", n.Name)
 }
 
+type RangeValue struct {
+	Start     float64
+	End       float64
+	Step      float64
+	Integral  bool // Counts with integers when every bound is one ---
+	Inclusive bool // Also yields End (start..=end) ---
+}
+
+func (r *RangeValue) Type() ValueTypes {
+	return RANGE_VALUE
+}
+
+func (r *RangeValue) String() string {
+	if r.Inclusive {
+		return fmt.Sprintf("%v..=%v", r.Start, r.End)
+	}
+	if r.Step == 1 {
+		return fmt.Sprintf("%v..%v", r.Start, r.End)
+	}
+	return fmt.Sprintf("range(%v, %v, %v)", r.Start, r.End, r.Step)
+}
+
+// Keys can be any value, see hashKey() for when two keys are the same ---
+type MapValue struct {
+	Entries *hashTable
+}
+
+func (m *MapValue) Type() ValueTypes {
+	return MAP_VALUE
+}
+
+func (m *MapValue) String() string {
+	return m.format(formatValue, map[RuntimeValue]bool{})
+}
+
+func (m *MapValue) format(format valueFormatter, visited map[RuntimeValue]bool) string {
+	if visited[m] {
+		return "#{...}"
+	}
+	visited[m] = true
+	defer delete(visited, m)
+
+	parts := make([]string, 0)
+	for _, entry := range m.Entries.snapshot() {
+		parts = append(parts, format(entry.Key, 0, visited)+": "+format(entry.Value, 0, visited))
+	}
+	return "#{" + strings.Join(parts, ", ") + "}"
+}
+
+type SetValue struct {
+	Members *hashTable
+}
+
+func (s *SetValue) Type() ValueTypes {
+	return SET_VALUE
+}
+
+func (s *SetValue) String() string {
+	return s.format(formatValue, map[RuntimeValue]bool{})
+}
+
+func (s *SetValue) format(format valueFormatter, visited map[RuntimeValue]bool) string {
+	if visited[s] {
+		return "#[...]"
+	}
+	visited[s] = true
+	defer delete(visited, s)
+
+	parts := make([]string, 0)
+	for _, entry := range s.Members.snapshot() {
+		parts = append(parts, format(entry.Key, 0, visited))
+	}
+	return "#[" + strings.Join(parts, ", ") + "]"
+}
+
 type BooleanValue struct {
 	Value bool
 }
@@ -222,6 +762,14 @@ func NATIVE_FUNCTION(name string, call func([]RuntimeValue, Environment, *Interp
 	}
 }
 
+func MAP() *MapValue {
+	return &MapValue{Entries: newHashTable()}
+}
+
+func SET() *SetValue {
+	return &SetValue{Members: newHashTable()}
+}
+
 func OBJECT(properties []ObjectPropertyValue) *ObjectValue {
 	return &ObjectValue{
 		Properties: properties,